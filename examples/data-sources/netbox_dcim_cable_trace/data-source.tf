@@ -0,0 +1,7 @@
+data "netbox_dcim_cable_trace" "cable_trace_test" {
+  interface_id = netbox_dcim_interface.interface_test.id
+}
+
+output "far_end" {
+  value = data.netbox_dcim_cable_trace.cable_trace_test.paths[0].segments[0].far_terminations
+}
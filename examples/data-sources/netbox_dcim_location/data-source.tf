@@ -0,0 +1,4 @@
+data "netbox_dcim_location" "location_test" {
+  slug = "test-location"
+  site_id = data.netbox_dcim_site.site_test.id
+}
@@ -0,0 +1,9 @@
+data "netbox_dcim_rack_elevation" "rack_elevation_test" {
+  rack_id = netbox_dcim_rack.rack_test.id
+  face = "front"
+  free_block_size = 2
+}
+
+output "free_position" {
+  value = data.netbox_dcim_rack_elevation.rack_elevation_test.free_block_start
+}
@@ -0,0 +1,7 @@
+data "netbox_dcim_region" "region_test" {
+  slug = "test-region"
+}
+
+output "region_path" {
+  value = join("/", concat(data.netbox_dcim_region.region_test.parents[*].slug, [data.netbox_dcim_region.region_test.slug]))
+}
@@ -0,0 +1,3 @@
+data "netbox_dcim_site_group" "site_group_test" {
+  slug = "test-site-group"
+}
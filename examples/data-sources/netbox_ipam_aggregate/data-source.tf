@@ -2,3 +2,7 @@ data "netbox_ipam_aggregate" "aggregate_test" {
   prefix = "192.168.56.0/24"
   rir_id = 1
 }
+
+data "netbox_ipam_aggregate" "aggregate_containing_prefix" {
+  contains = "192.168.56.128/25"
+}
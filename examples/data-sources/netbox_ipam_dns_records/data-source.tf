@@ -0,0 +1,4 @@
+data "netbox_ipam_dns_records" "dns_records_test" {
+  prefix = "192.168.56.0/24"
+  vrf_id = 1
+}
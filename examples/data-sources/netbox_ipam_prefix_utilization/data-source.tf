@@ -0,0 +1,3 @@
+data "netbox_ipam_prefix_utilization" "prefix_utilization_test" {
+  prefix_id = netbox_ipam_prefix.prefix_test.id
+}
@@ -0,0 +1,3 @@
+data "netbox_ipam_route_target" "route_target_test" {
+  name = "65000:100"
+}
@@ -0,0 +1,4 @@
+data "netbox_ipam_vrf" "vrf_test" {
+  name = "TestVrf"
+  rd = "65000:100"
+}
@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_console_port.console_port_test 1
+
+# Import by device name and console port name
+terraform import netbox_dcim_console_port.console_port_test "device-01/con0"
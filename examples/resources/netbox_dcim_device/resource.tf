@@ -0,0 +1,95 @@
+resource "netbox_dcim_device" "device_test" {
+  name = "Test device"
+  device_type_id = netbox_dcim_device_type.device_type_test.id
+  role_id = netbox_dcim_device_role.device_role_test.id
+  site_id = netbox_dcim_site.site_test.id
+  rack_id = netbox_dcim_rack.rack_test.id
+  face = "front"
+  position = 10
+  platform_id = netbox_dcim_platform.platform_test.id
+  tenant_id = netbox_tenancy_tenant.tenant_test.id
+  serial = "ABC123456"
+  asset_tag = "asset-123"
+  status = "planned"
+  comments = "Device for testing"
+  local_context_data = jsonencode({
+    ntp_servers = ["192.0.2.1"]
+  })
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = data.netbox_dcim_platform.platform_test.id
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      data.netbox_dcim_platform.platform_test.id,
+      data.netbox_dcim_platform.platform_test2.id
+    ])
+  }
+}
@@ -0,0 +1,5 @@
+resource "netbox_dcim_device_primary_ip" "device_primary_ip_test" {
+  device_id = 1
+  primary_ip4_id = netbox_ipam_ip_addresses.ip_test.id
+  primary_ip6_id = netbox_ipam_ip_addresses.ip6_test.id
+}
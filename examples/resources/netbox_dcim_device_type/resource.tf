@@ -0,0 +1,129 @@
+resource "netbox_dcim_device_type" "device_type_test" {
+  manufacturer_id = netbox_dcim_manufacturer.manufacturer_test.id
+  model = "Test device type"
+  slug = "test-device-type"
+  part_number = "TDT-1"
+  u_height = 1
+  airflow = "front-to-rear"
+  comments = "Device type for testing"
+
+  console_port_template {
+    name = "Console"
+    type = "rj-45"
+  }
+
+  power_port_template {
+    name = "PSU1"
+    type = "iec-60320-c14"
+    maximum_draw = 350
+  }
+
+  interface_template {
+    name = "mgmt0"
+    type = "1000base-t"
+    mgmt_only = true
+  }
+
+  interface_template {
+    name = "eth0"
+    type = "10gbase-x-sfpp"
+  }
+
+  rear_port_template {
+    name = "Rear1"
+    type = "lc"
+  }
+
+  front_port_template {
+    name = "Front1"
+    type = "lc"
+    rear_port = "Rear1"
+  }
+
+  module_bay_template {
+    name = "Slot1"
+    position = "1"
+  }
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = data.netbox_dcim_platform.platform_test.id
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      data.netbox_dcim_platform.platform_test.id,
+      data.netbox_dcim_platform.platform_test2.id
+    ])
+  }
+}
+
+resource "netbox_dcim_device_type" "device_type_library_test" {
+  manufacturer_id = netbox_dcim_manufacturer.manufacturer_test.id
+  library_yaml = file("${path.module}/device-types/Cisco/C9200L-24T-4G.yaml")
+}
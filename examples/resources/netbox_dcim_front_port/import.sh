@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_front_port.front_port_test 1
+
+# Import by device name and front port name
+terraform import netbox_dcim_front_port.front_port_test "device-01/front1"
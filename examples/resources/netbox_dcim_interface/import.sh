@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_interface.interface_test 1
+
+# Import by device name and interface name
+terraform import netbox_dcim_interface.interface_test "device-01/GigabitEthernet0/1"
@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_inventory_item.inventory_item_test 1
+
+# Import by device name and inventory item name
+terraform import netbox_dcim_inventory_item.inventory_item_test "device-01/Transceiver"
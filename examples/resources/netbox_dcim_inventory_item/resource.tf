@@ -0,0 +1,92 @@
+resource "netbox_dcim_inventory_item" "inventory_item_test" {
+  device_id = netbox_dcim_device.device_test.id
+  name = "Transceiver"
+  label = "SFP+ 0"
+  parent_id = netbox_dcim_inventory_item.inventory_item_parent.id
+  component_type = "dcim.interface"
+  component_id = netbox_dcim_interface.interface_test.id
+  role_id = netbox_dcim_inventory_item_role.inventory_item_role_test.id
+  manufacturer_id = netbox_dcim_manufacturer.manufacturer_test.id
+  part_id = "SFP-10G-SR"
+  serial = "ABC123"
+  asset_tag = "INV-0001"
+  discovered = true
+  description = "Inventory item for testing"
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = data.netbox_dcim_platform.platform_test.id
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      data.netbox_dcim_platform.platform_test.id,
+      data.netbox_dcim_platform.platform_test2.id
+    ])
+  }
+}
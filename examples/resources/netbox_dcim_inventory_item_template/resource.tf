@@ -0,0 +1,10 @@
+resource "netbox_dcim_inventory_item_template" "inventory_item_template_test" {
+  device_type_id = netbox_dcim_device_type.device_type_test.id
+  name = "PSU1"
+  label = "PSU 1"
+  parent_id = netbox_dcim_inventory_item_template.inventory_item_template_parent.id
+  role_id = netbox_dcim_inventory_item_role.inventory_item_role_test.id
+  manufacturer_id = netbox_dcim_manufacturer.manufacturer_test.id
+  part_id = "PWR-650W-AC"
+  description = "Inventory item template for testing"
+}
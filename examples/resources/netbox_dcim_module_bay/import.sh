@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_module_bay.module_bay_test 1
+
+# Import by device name and module bay name
+terraform import netbox_dcim_module_bay.module_bay_test "device-01/Slot1"
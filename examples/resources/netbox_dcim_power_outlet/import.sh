@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_power_outlet.power_outlet_test 1
+
+# Import by device name and power outlet name
+terraform import netbox_dcim_power_outlet.power_outlet_test "device-01/outlet1"
@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_power_port.power_port_test 1
+
+# Import by device name and power port name
+terraform import netbox_dcim_power_port.power_port_test "device-01/PSU0"
@@ -0,0 +1,96 @@
+resource "netbox_dcim_rack" "rack_test" {
+  name = "Test rack"
+  site_id = netbox_dcim_site.site_test.id
+  location_id = netbox_dcim_location.location_test.id
+  role_id = netbox_dcim_rack_role.rack_role_test.id
+  tenant_id = netbox_tenancy_tenant.tenant_test.id
+  status = "planned"
+  type = "4-post-cabinet"
+  width = 19
+  u_height = 42
+  desc_units = false
+  outer_depth = 1000
+  outer_width = 600
+  outer_unit = "mm"
+  asset_tag = "RACK-0001"
+  serial = "SN-0001"
+  facility_id = "DC1-R01"
+  comments = "Rack for testing"
+
+  tag {
+    name = "tag1"
+    slug = "tag1"
+  }
+
+  custom_field {
+    name = "cf_boolean"
+    type = "boolean"
+    value = "true"
+  }
+
+  custom_field {
+    name = "cf_date"
+    type = "date"
+    value = "2020-12-25"
+  }
+
+  custom_field {
+    name = "cf_text"
+    type = "text"
+    value = "some text"
+  }
+
+  custom_field {
+    name = "cf_integer"
+    type = "integer"
+    value = "10"
+  }
+
+  custom_field {
+    name = "cf_selection"
+    type = "select"
+    value = "1"
+  }
+
+  custom_field {
+    name = "cf_url"
+    type = "url"
+    value = "https://github.com"
+  }
+
+  custom_field {
+    name = "cf_multi_selection"
+    type = "multiselect"
+    value = jsonencode([
+      "0",
+      "1"
+    ])
+  }
+
+  custom_field {
+    name = "cf_json"
+    type = "json"
+    value = jsonencode({
+      stringvalue = "string"
+      boolvalue = false
+      dictionary = {
+        numbervalue = 5
+      }
+    })
+  }
+
+  custom_field {
+    name = "cf_object"
+    type = "object"
+    value = data.netbox_dcim_platform.platform_test.id
+  }
+
+  custom_field {
+    name = "cf_multi_object"
+    type = "multiobject"
+    value = jsonencode([
+      data.netbox_dcim_platform.platform_test.id,
+      data.netbox_dcim_platform.platform_test2.id
+    ])
+  }
+}
@@ -0,0 +1,5 @@
+# Import by ID
+terraform import netbox_dcim_rear_port.rear_port_test 1
+
+# Import by device name and rear port name
+terraform import netbox_dcim_rear_port.rear_port_test "device-01/rear1"
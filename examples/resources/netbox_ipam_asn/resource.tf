@@ -1,4 +1,4 @@
-resource "netbox_ipam_aggregate" "aggregate_test" {
+resource "netbox_ipam_asn" "asn_test" {
   asn = "65530"
   rir_id = netbox_ipam_rir.rir_test.id
 
@@ -82,3 +82,13 @@ resource "netbox_ipam_aggregate" "aggregate_test" {
     ])
   }
 }
+
+resource "netbox_ipam_asn" "allocated_asn_test" {
+  rir_id = netbox_ipam_rir.rir_test.id
+  site_ids = [netbox_dcim_site.site_test.id]
+
+  allocation_range {
+    start = 4200000000
+    end = 4200009999
+  }
+}
@@ -0,0 +1,6 @@
+resource "netbox_ipam_fhrp_group_assignment" "fhrp_group_assignment_test" {
+  group_id = netbox_ipam_fhrp_group.fhrp_group_test.id
+  interface_id = netbox_virtualization_interface.interface_test.id
+  interface_type = "virtualization.vminterface"
+  priority = 200
+}
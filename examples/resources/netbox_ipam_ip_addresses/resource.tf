@@ -86,6 +86,7 @@ resource "netbox_ipam_ip_addresses" "ip6_test" {
   status      = "active"
   object_id   = netbox_virtualization_interface.interface_test.id
   object_type = netbox_virtualization_interface.interface_test.type
+  primary     = true
 }
 
 resource "netbox_ipam_ip_addresses" "dynamic_ip_from_prefix" {
@@ -99,3 +100,13 @@ resource "netbox_ipam_ip_addresses" "dynamic_ip_from_ip_range" {
   description = "Dynamic IP in IP range created by terraform"
   status = "active"
 }
+
+resource "netbox_ipam_ip_addresses" "ip_assigned_by_name" {
+  address = "192.168.57.1/24"
+  status  = "active"
+
+  assigned_interface {
+    virtualmachine_name = netbox_virtualization_vm.vm_test.name
+    name                = netbox_virtualization_interface.interface_test.name
+  }
+}
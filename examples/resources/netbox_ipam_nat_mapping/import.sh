@@ -0,0 +1,3 @@
+# Import by ID of the outside IP address. The IP addresses of an imported NAT
+# mapping are considered existing ones, destroying it only unlinks them.
+terraform import netbox_ipam_nat_mapping.nat_mapping_test 1
@@ -0,0 +1,4 @@
+resource "netbox_ipam_nat_mapping" "nat_mapping_test" {
+  inside_id       = netbox_ipam_ip_addresses.ip_test.id
+  outside_address = "203.0.113.10/32"
+}
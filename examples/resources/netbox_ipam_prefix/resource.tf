@@ -5,6 +5,7 @@ resource "netbox_ipam_prefix" "prefix_test" {
   site_id = netbox_ipam_vlan_group.vlan_group_test.site_id
   role_id = data.netbox_ipam_role.vlan_role_production.id
   status = "active"
+  mark_utilized = false
 
   tag {
     name = "tag1"
@@ -83,3 +83,9 @@ resource "netbox_ipam_service" "service_test" {
     ])
   }
 }
+
+resource "netbox_ipam_service" "service_from_template_test" {
+  name                = "SSH"
+  virtualmachine_id   = netbox_virtualization_vm.vm_test.id
+  service_template_id = netbox_ipam_service_template.service_template_test.id
+}
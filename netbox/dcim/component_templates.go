@@ -0,0 +1,1053 @@
+package dcim
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+)
+
+// Number of component templates read per request
+const componentTemplatesPageSize int64 = 1000
+
+// componentTemplateParent is the device type or the module type owning
+// component templates, only one of the IDs is set
+type componentTemplateParent struct {
+	deviceTypeID int64
+	moduleTypeID int64
+}
+
+func (p componentTemplateParent) deviceType() *int64 {
+	if p.deviceTypeID == 0 {
+		return nil
+	}
+	return &p.deviceTypeID
+}
+
+func (p componentTemplateParent) moduleType() *int64 {
+	if p.moduleTypeID == 0 {
+		return nil
+	}
+	return &p.moduleTypeID
+}
+
+// filters returns the devicetype_id and moduletype_id filters selecting the
+// templates of the parent
+func (p componentTemplateParent) filters() (*string, *string) {
+	if p.deviceTypeID != 0 {
+		id := fmt.Sprint(p.deviceTypeID)
+		return &id, nil
+	}
+	id := fmt.Sprint(p.moduleTypeID)
+	return nil, &id
+}
+
+// componentTemplateKind describes how a kind of component templates is
+// managed. The templates are identified by their name within their parent.
+type componentTemplateKind struct {
+	// Schema key of the templates
+	key string
+	// Key of the templates in the YAML files of the devicetype-library
+	yamlKey string
+	// Fields of a template besides name, label and description
+	fields map[string]*schema.Schema
+	// Field holding the name of a template of the kind refKind referenced by
+	// the templates of this kind
+	refField string
+	refKind  string
+
+	list   func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error)
+	write  func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64, template map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error
+	delete func(client *netboxclient.NetBoxAPI, id int64) error
+}
+
+// schema returns the schema of the set of templates of this kind
+func (k *componentTemplateKind) schema(description string) *schema.Schema {
+	fields := map[string]*schema.Schema{
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 200),
+			Description:  "The description of this template.",
+		},
+		"label": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 64),
+			Description:  "The physical label of this template.",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+			Description:  "The name of this template, unique among the templates of the same kind.",
+		},
+	}
+	for name, field := range k.fields {
+		fields[name] = field
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+// fromLibrary converts the templates of this kind read from a YAML file of
+// the devicetype-library into values of the schema, the unknown keys are
+// ignored
+func (k *componentTemplateKind) fromLibrary(value interface{}) ([]interface{}, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", k.yamlKey)
+	}
+
+	elem := k.schema("").Elem.(*schema.Resource).Schema
+	templates := []interface{}{}
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the items of %s must be maps", k.yamlKey)
+		}
+
+		template := map[string]interface{}{}
+		for name, field := range elem {
+			v, err := convertLibraryValue(fields[name], field)
+			if err != nil {
+				return nil, fmt.Errorf("%s of %s: %w", name, k.yamlKey, err)
+			}
+			template[name] = v
+		}
+		if template["name"] == "" {
+			return nil, fmt.Errorf("the items of %s must have a name", k.yamlKey)
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// convertLibraryValue converts a value read from YAML to the type of field,
+// its default or zero value when it is missing
+func convertLibraryValue(value interface{}, field *schema.Schema) (interface{}, error) {
+	if value == nil {
+		if field.Default != nil {
+			return field.Default, nil
+		}
+		return field.ZeroValue(), nil
+	}
+
+	switch field.Type {
+	case schema.TypeString:
+		return fmt.Sprint(value), nil
+	case schema.TypeBool:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case schema.TypeInt:
+		if v, ok := value.(int); ok {
+			return v, nil
+		}
+	case schema.TypeFloat:
+		switch v := value.(type) {
+		case int:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected value %v", value)
+}
+
+// modifiedFields returns the fields of template to send even when they are
+// empty so that they are cleared on update
+func (k *componentTemplateKind) modifiedFields(template map[string]interface{},
+	refID int64) map[string]interface{} {
+	modifiedFields := map[string]interface{}{}
+	for name, value := range template {
+		if name == k.refField {
+			continue
+		}
+		switch v := value.(type) {
+		case int:
+			modifiedFields[name] = int64(v)
+		case string, bool:
+			modifiedFields[name] = v
+		}
+	}
+	if k.refField != "" {
+		modifiedFields[k.refField] = refID
+	}
+
+	return modifiedFields
+}
+
+// reconcileComponentTemplates makes the templates of parent match the ones
+// of the schema keys of kinds in d. The templates are updated in place when
+// they keep their name. kinds must list the referenced kinds first.
+func reconcileComponentTemplates(client *netboxclient.NetBoxAPI, parent componentTemplateParent,
+	kinds []*componentTemplateKind, get func(key string) interface{}) error {
+	existing := map[string]map[string]map[string]interface{}{}
+	desired := map[string]map[string]map[string]interface{}{}
+
+	for _, kind := range kinds {
+		templates, err := kind.list(client, parent)
+		if err != nil {
+			return err
+		}
+		existing[kind.key] = map[string]map[string]interface{}{}
+		for _, template := range templates {
+			existing[kind.key][template["name"].(string)] = template
+		}
+
+		desired[kind.key] = map[string]map[string]interface{}{}
+		for _, template := range get(kind.key).(*schema.Set).List() {
+			t := template.(map[string]interface{})
+			desired[kind.key][t["name"].(string)] = t
+		}
+	}
+
+	// The templates referencing other ones are deleted first
+	deleted := false
+	for i := len(kinds) - 1; i >= 0; i-- {
+		kind := kinds[i]
+		for name, template := range existing[kind.key] {
+			if _, ok := desired[kind.key][name]; !ok {
+				if err := kind.delete(client, template["id"].(int64)); err != nil {
+					return err
+				}
+				delete(existing[kind.key], name)
+				deleted = true
+			}
+		}
+	}
+
+	// Netbox deletes the templates referencing a deleted template along with
+	// it (e.g. the front ports of a renamed rear port), the referencing kinds
+	// are listed again so that they are recreated
+	if deleted {
+		for _, kind := range kinds {
+			if kind.refField == "" {
+				continue
+			}
+			templates, err := kind.list(client, parent)
+			if err != nil {
+				return err
+			}
+			existing[kind.key] = map[string]map[string]interface{}{}
+			for _, template := range templates {
+				existing[kind.key][template["name"].(string)] = template
+			}
+		}
+	}
+
+	ids := map[string]map[string]int64{}
+	for _, kind := range kinds {
+		for name, template := range desired[kind.key] {
+			var refID int64
+			if kind.refField != "" {
+				if ref := template[kind.refField].(string); ref != "" {
+					id, ok := ids[kind.refKind][ref]
+					if !ok {
+						return fmt.Errorf("%s %s references the unknown %s %s", kind.key, name,
+							kind.refKind, ref)
+					}
+					refID = id
+				}
+			}
+
+			var id int64
+			if current, ok := existing[kind.key][name]; ok {
+				id = current["id"].(int64)
+				if componentTemplateEqual(current, template) {
+					continue
+				}
+			}
+
+			modifier := requestmodifier.NewNetboxRequestModifier(kind.modifiedFields(template, refID), []string{})
+			if err := kind.write(client, parent, id, template, refID, modifier); err != nil {
+				return err
+			}
+		}
+
+		ids[kind.key] = map[string]int64{}
+		templates, err := kind.list(client, parent)
+		if err != nil {
+			return err
+		}
+		for _, template := range templates {
+			ids[kind.key][template["name"].(string)] = template["id"].(int64)
+		}
+	}
+
+	return nil
+}
+
+// planEmptyComponentTemplates plans no templates for the schema keys of kinds
+// without any block in the configuration, so that removing all the blocks of
+// a kind deletes its templates
+func planEmptyComponentTemplates(d *schema.ResourceDiff, kinds []*componentTemplateKind) error {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+
+	for _, kind := range kinds {
+		templates := config.GetAttr(kind.key)
+		if templates.IsKnown() && (templates.IsNull() || templates.LengthInt() == 0) {
+			if err := d.SetNew(kind.key, []interface{}{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// componentTemplateEqual returns whether the template read from Netbox has
+// the values of the template of the schema
+func componentTemplateEqual(current, template map[string]interface{}) bool {
+	for name, value := range template {
+		if !reflect.DeepEqual(current[name], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// readComponentTemplates returns the templates of parent for each schema
+// key of kinds
+func readComponentTemplates(client *netboxclient.NetBoxAPI, parent componentTemplateParent,
+	kinds []*componentTemplateKind) (map[string][]map[string]interface{}, error) {
+	templates := map[string][]map[string]interface{}{}
+	for _, kind := range kinds {
+		list, err := kind.list(client, parent)
+		if err != nil {
+			return nil, err
+		}
+		for _, template := range list {
+			delete(template, "id")
+		}
+		templates[kind.key] = list
+	}
+
+	return templates, nil
+}
+
+// readAllPages calls read with growing offsets until all the objects
+// counted by Netbox have been read
+func readAllPages(read func(limit, offset *int64) (int64, int, error)) error {
+	limit := componentTemplatesPageSize
+	for offset := int64(0); ; {
+		count, n, err := read(&limit, &offset)
+		if err != nil {
+			return err
+		}
+		offset += int64(n)
+		if n == 0 || offset >= count {
+			return nil
+		}
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intValue(i *int64) int {
+	if i == nil {
+		return 0
+	}
+	return int(*i)
+}
+
+func int64Pointer(i int) *int64 {
+	if i == 0 {
+		return nil
+	}
+	v := int64(i)
+	return &v
+}
+
+var consolePortTemplateKind = &componentTemplateKind{
+	key:     "console_port_template",
+	yamlKey: "console-ports",
+	fields: map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The type of this console port template (e.g. rj-45).",
+		},
+	},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimConsolePortTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimConsolePortTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"description": t.Description,
+					"id":          t.ID,
+					"label":       t.Label,
+					"name":        stringValue(t.Name),
+					"type":        "",
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		data := &models.WritableConsolePortTemplate{
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			Label:       t["label"].(string),
+			ModuleType:  parent.moduleType(),
+			Name:        &name,
+			Type:        t["type"].(string),
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimConsolePortTemplatesCreate(
+				dcim.NewDcimConsolePortTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimConsolePortTemplatesPartialUpdate(
+			dcim.NewDcimConsolePortTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimConsolePortTemplatesDelete(
+			dcim.NewDcimConsolePortTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var consoleServerPortTemplateKind = &componentTemplateKind{
+	key:     "console_server_port_template",
+	yamlKey: "console-server-ports",
+	fields: map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The type of this console server port template (e.g. rj-45).",
+		},
+	},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimConsoleServerPortTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimConsoleServerPortTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"description": t.Description,
+					"id":          t.ID,
+					"label":       t.Label,
+					"name":        stringValue(t.Name),
+					"type":        "",
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		data := &models.WritableConsoleServerPortTemplate{
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			Label:       t["label"].(string),
+			ModuleType:  parent.moduleType(),
+			Name:        &name,
+			Type:        t["type"].(string),
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimConsoleServerPortTemplatesCreate(
+				dcim.NewDcimConsoleServerPortTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimConsoleServerPortTemplatesPartialUpdate(
+			dcim.NewDcimConsoleServerPortTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimConsoleServerPortTemplatesDelete(
+			dcim.NewDcimConsoleServerPortTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var powerPortTemplateKind = &componentTemplateKind{
+	key:     "power_port_template",
+	yamlKey: "power-ports",
+	fields: map[string]*schema.Schema{
+		"allocated_draw": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 32767),
+			Description:  "The allocated power draw in watts of this power port template.",
+		},
+		"maximum_draw": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 32767),
+			Description:  "The maximum power draw in watts of this power port template.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The type of this power port template (e.g. iec-60320-c14).",
+		},
+	},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimPowerPortTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimPowerPortTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"allocated_draw": intValue(t.AllocatedDraw),
+					"description":    t.Description,
+					"id":             t.ID,
+					"label":          t.Label,
+					"maximum_draw":   intValue(t.MaximumDraw),
+					"name":           stringValue(t.Name),
+					"type":           "",
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		data := &models.WritablePowerPortTemplate{
+			AllocatedDraw: int64Pointer(t["allocated_draw"].(int)),
+			Description:   t["description"].(string),
+			DeviceType:    parent.deviceType(),
+			Label:         t["label"].(string),
+			MaximumDraw:   int64Pointer(t["maximum_draw"].(int)),
+			ModuleType:    parent.moduleType(),
+			Name:          &name,
+			Type:          t["type"].(string),
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimPowerPortTemplatesCreate(
+				dcim.NewDcimPowerPortTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimPowerPortTemplatesPartialUpdate(
+			dcim.NewDcimPowerPortTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimPowerPortTemplatesDelete(
+			dcim.NewDcimPowerPortTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var powerOutletTemplateKind = &componentTemplateKind{
+	key:     "power_outlet_template",
+	yamlKey: "power-outlets",
+	fields: map[string]*schema.Schema{
+		"feed_leg": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"A", "B", "C"}, false),
+			Description:  "The phase of this power outlet template among A, B or C.",
+		},
+		"power_port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the power port template feeding this power outlet template.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The type of this power outlet template (e.g. iec-60320-c13).",
+		},
+	},
+	refField: "power_port",
+	refKind:  "power_port_template",
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimPowerOutletTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimPowerOutletTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"description": t.Description,
+					"feed_leg":    "",
+					"id":          t.ID,
+					"label":       t.Label,
+					"name":        stringValue(t.Name),
+					"power_port":  "",
+					"type":        "",
+				}
+				if t.FeedLeg != nil {
+					template["feed_leg"] = stringValue(t.FeedLeg.Value)
+				}
+				if t.PowerPort != nil {
+					template["power_port"] = stringValue(t.PowerPort.Name)
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		data := &models.WritablePowerOutletTemplate{
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			FeedLeg:     t["feed_leg"].(string),
+			Label:       t["label"].(string),
+			ModuleType:  parent.moduleType(),
+			Name:        &name,
+			Type:        t["type"].(string),
+		}
+		if refID != 0 {
+			data.PowerPort = &refID
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimPowerOutletTemplatesCreate(
+				dcim.NewDcimPowerOutletTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimPowerOutletTemplatesPartialUpdate(
+			dcim.NewDcimPowerOutletTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimPowerOutletTemplatesDelete(
+			dcim.NewDcimPowerOutletTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var interfaceTemplateKind = &componentTemplateKind{
+	key:     "interface_template",
+	yamlKey: "interfaces",
+	fields: map[string]*schema.Schema{
+		"mgmt_only": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether this interface template is only used for management.",
+		},
+		"poe_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"pd", "pse"}, false),
+			Description:  "The PoE mode of this interface template among pd or pse.",
+		},
+		"poe_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The PoE type of this interface template (e.g. type1-ieee802.3af).",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The type of this interface template (e.g. 1000base-t).",
+		},
+	},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimInterfaceTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimInterfaceTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"description": t.Description,
+					"id":          t.ID,
+					"label":       t.Label,
+					"mgmt_only":   t.MgmtOnly,
+					"name":        stringValue(t.Name),
+					"poe_mode":    "",
+					"poe_type":    "",
+					"type":        "",
+				}
+				if t.PoeMode != nil {
+					template["poe_mode"] = stringValue(t.PoeMode.Value)
+				}
+				if t.PoeType != nil {
+					template["poe_type"] = stringValue(t.PoeType.Value)
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		interfaceType := t["type"].(string)
+		data := &models.WritableInterfaceTemplate{
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			Label:       t["label"].(string),
+			MgmtOnly:    t["mgmt_only"].(bool),
+			ModuleType:  parent.moduleType(),
+			Name:        &name,
+			PoeMode:     t["poe_mode"].(string),
+			PoeType:     t["poe_type"].(string),
+			Type:        &interfaceType,
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimInterfaceTemplatesCreate(
+				dcim.NewDcimInterfaceTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimInterfaceTemplatesPartialUpdate(
+			dcim.NewDcimInterfaceTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimInterfaceTemplatesDelete(
+			dcim.NewDcimInterfaceTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var rearPortTemplateKind = &componentTemplateKind{
+	key:     "rear_port_template",
+	yamlKey: "rear-ports",
+	fields: map[string]*schema.Schema{
+		"color": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 6),
+			Description:  "The color of this rear port template (e.g. ff0000).",
+		},
+		"positions": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 1024),
+			Description:  "The number of front ports which may be mapped to this rear port template (1 by default).",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The type of this rear port template (e.g. 8p8c).",
+		},
+	},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimRearPortTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimRearPortTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"color":       t.Color,
+					"description": t.Description,
+					"id":          t.ID,
+					"label":       t.Label,
+					"name":        stringValue(t.Name),
+					"positions":   int(t.Positions),
+					"type":        "",
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		portType := t["type"].(string)
+		data := &models.WritableRearPortTemplate{
+			Color:       t["color"].(string),
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			Label:       t["label"].(string),
+			ModuleType:  parent.moduleType(),
+			Name:        &name,
+			Positions:   int64(t["positions"].(int)),
+			Type:        &portType,
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimRearPortTemplatesCreate(
+				dcim.NewDcimRearPortTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimRearPortTemplatesPartialUpdate(
+			dcim.NewDcimRearPortTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimRearPortTemplatesDelete(
+			dcim.NewDcimRearPortTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var frontPortTemplateKind = &componentTemplateKind{
+	key:     "front_port_template",
+	yamlKey: "front-ports",
+	fields: map[string]*schema.Schema{
+		"color": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 6),
+			Description:  "The color of this front port template (e.g. ff0000).",
+		},
+		"rear_port": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the rear port template mapped to this front port template.",
+		},
+		"rear_port_position": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 1024),
+			Description:  "The position of this front port template on the rear port (1 by default).",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The type of this front port template (e.g. 8p8c).",
+		},
+	},
+	refField: "rear_port",
+	refKind:  "rear_port_template",
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimFrontPortTemplatesListParams()
+		params.DevicetypeID, params.ModuletypeID = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimFrontPortTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				template := map[string]interface{}{
+					"color":              t.Color,
+					"description":        t.Description,
+					"id":                 t.ID,
+					"label":              t.Label,
+					"name":               stringValue(t.Name),
+					"rear_port":          "",
+					"rear_port_position": int(t.RearPortPosition),
+					"type":               "",
+				}
+				if t.RearPort != nil {
+					template["rear_port"] = stringValue(t.RearPort.Name)
+				}
+				if t.Type != nil {
+					template["type"] = stringValue(t.Type.Value)
+				}
+				templates = append(templates, template)
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		portType := t["type"].(string)
+		data := &models.WritableFrontPortTemplate{
+			Color:            t["color"].(string),
+			Description:      t["description"].(string),
+			DeviceType:       parent.deviceType(),
+			Label:            t["label"].(string),
+			ModuleType:       parent.moduleType(),
+			Name:             &name,
+			RearPort:         &refID,
+			RearPortPosition: int64(t["rear_port_position"].(int)),
+			Type:             &portType,
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimFrontPortTemplatesCreate(
+				dcim.NewDcimFrontPortTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimFrontPortTemplatesPartialUpdate(
+			dcim.NewDcimFrontPortTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimFrontPortTemplatesDelete(
+			dcim.NewDcimFrontPortTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var deviceBayTemplateKind = &componentTemplateKind{
+	key:     "device_bay_template",
+	yamlKey: "device-bays",
+	fields:  map[string]*schema.Schema{},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimDeviceBayTemplatesListParams()
+		params.DevicetypeID, _ = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimDeviceBayTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				templates = append(templates, map[string]interface{}{
+					"description": t.Description,
+					"id":          t.ID,
+					"label":       t.Label,
+					"name":        stringValue(t.Name),
+				})
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		data := &models.WritableDeviceBayTemplate{
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			Label:       t["label"].(string),
+			Name:        &name,
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimDeviceBayTemplatesCreate(
+				dcim.NewDcimDeviceBayTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimDeviceBayTemplatesPartialUpdate(
+			dcim.NewDcimDeviceBayTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimDeviceBayTemplatesDelete(
+			dcim.NewDcimDeviceBayTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
+
+var moduleBayTemplateKind = &componentTemplateKind{
+	key:     "module_bay_template",
+	yamlKey: "module-bays",
+	fields: map[string]*schema.Schema{
+		"position": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 30),
+			Description:  "The position of this module bay template, used to name the components of the modules installed in it.",
+		},
+	},
+	list: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent) ([]map[string]interface{}, error) {
+		templates := []map[string]interface{}{}
+		params := dcim.NewDcimModuleBayTemplatesListParams()
+		params.DevicetypeID, _ = parent.filters()
+		err := readAllPages(func(limit, offset *int64) (int64, int, error) {
+			list, err := client.Dcim.DcimModuleBayTemplatesList(params.WithLimit(limit).WithOffset(offset), nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, t := range list.Payload.Results {
+				templates = append(templates, map[string]interface{}{
+					"description": t.Description,
+					"id":          t.ID,
+					"label":       t.Label,
+					"name":        stringValue(t.Name),
+					"position":    t.Position,
+				})
+			}
+			return *list.Payload.Count, len(list.Payload.Results), nil
+		})
+		return templates, err
+	},
+	write: func(client *netboxclient.NetBoxAPI, parent componentTemplateParent, id int64,
+		t map[string]interface{}, refID int64, modifier func(*runtime.ClientOperation)) error {
+		name := t["name"].(string)
+		data := &models.WritableModuleBayTemplate{
+			Description: t["description"].(string),
+			DeviceType:  parent.deviceType(),
+			Label:       t["label"].(string),
+			Name:        &name,
+			Position:    t["position"].(string),
+		}
+		if id == 0 {
+			_, err := client.Dcim.DcimModuleBayTemplatesCreate(
+				dcim.NewDcimModuleBayTemplatesCreateParams().WithData(data), nil)
+			return err
+		}
+		_, err := client.Dcim.DcimModuleBayTemplatesPartialUpdate(
+			dcim.NewDcimModuleBayTemplatesPartialUpdateParams().WithID(id).WithData(data), nil, modifier)
+		return err
+	},
+	delete: func(client *netboxclient.NetBoxAPI, id int64) error {
+		_, err := client.Dcim.DcimModuleBayTemplatesDelete(
+			dcim.NewDcimModuleBayTemplatesDeleteParams().WithID(id), nil)
+		return err
+	},
+}
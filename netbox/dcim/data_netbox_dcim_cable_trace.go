@@ -0,0 +1,286 @@
+package dcim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+)
+
+// Keys of the objects whose cable paths can be traced
+var cableTraceOrigins = []string{"front_port_id", "interface_id", "rear_port_id"}
+
+// Link of an object of the API, from which its type is deduced
+var cableTraceObjectURL = regexp.MustCompile(`/api/([a-z-]+)/([a-z-]+)/[0-9]+/?$`)
+
+// cableTraceObject is a termination or a cable along a cable path
+type cableTraceObject struct {
+	ID      int64  `json:"id"`
+	Display string `json:"display"`
+	Label   string `json:"label"`
+	URL     string `json:"url"`
+}
+
+// cableTraceSegment is a hop of a cable path, from the near terminations
+// through a cable to the far terminations
+type cableTraceSegment struct {
+	near  []cableTraceObject
+	cable *cableTraceObject
+	far   []cableTraceObject
+}
+
+// cablePath is a cable path traversing a pass-through port
+type cablePath struct {
+	Path [][]cableTraceObject `json:"path"`
+}
+
+var cableTraceTerminationsSchema = schema.Schema{
+	Type:     schema.TypeList,
+	Computed: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The display name of this termination.",
+			},
+			"id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of this termination.",
+			},
+			"object_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this termination, e.g. dcim.interface.",
+			},
+		},
+	},
+}
+
+func DataNetboxDcimCableTrace() *schema.Resource {
+	nearTerminations := cableTraceTerminationsSchema
+	nearTerminations.Description = "The terminations at the near end of this segment."
+	farTerminations := cableTraceTerminationsSchema
+	farTerminations.Description = "The terminations at the far end of this segment, empty if the path ends unconnected."
+
+	return &schema.Resource{
+		Description: "Trace the cable paths of an interface or a pass-through port (dcim module) from netbox.",
+		ReadContext: dataNetboxDcimCableTraceRead,
+
+		Schema: map[string]*schema.Schema{
+			"front_port_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: cableTraceOrigins,
+				Description:  "The ID of the front port (dcim module) whose traversing paths are read.",
+			},
+			"interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: cableTraceOrigins,
+				Description:  "The ID of the interface (dcim module) whose path is traced.",
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cable paths, a single one for an interface and every path traversing the port for a front or rear port.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segments": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The segments of this path, in order from its origin.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cable_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The ID of the cable of this segment, 0 if there is none.",
+									},
+									"cable_label": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The label of the cable of this segment.",
+									},
+									"far_terminations":  &farTerminations,
+									"near_terminations": &nearTerminations,
+								},
+							},
+						},
+					},
+				},
+			},
+			"rear_port_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: cableTraceOrigins,
+				Description:  "The ID of the rear port (dcim module) whose traversing paths are read.",
+			},
+		},
+	}
+}
+
+func dataNetboxDcimCableTraceRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	var paths [][]cableTraceSegment
+	var id string
+
+	if interfaceID := int64(d.Get("interface_id").(int)); interfaceID != 0 {
+		trace := [][]json.RawMessage{}
+		if err := getCablePaths(client, "/dcim/interfaces/{id}/trace/", interfaceID, &trace); err != nil {
+			return diag.FromErr(err)
+		}
+		segments, err := convertCableTrace(trace)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		paths = append(paths, segments)
+		id = fmt.Sprintf("NetboxDcimCableTrace/interface/%d", interfaceID)
+	} else {
+		path := "/dcim/front-ports/{id}/paths/"
+		portID := int64(d.Get("front_port_id").(int))
+		id = fmt.Sprintf("NetboxDcimCableTrace/front_port/%d", portID)
+		if portID == 0 {
+			path = "/dcim/rear-ports/{id}/paths/"
+			portID = int64(d.Get("rear_port_id").(int))
+			id = fmt.Sprintf("NetboxDcimCableTrace/rear_port/%d", portID)
+		}
+
+		cablePaths := []cablePath{}
+		if err := getCablePaths(client, path, portID, &cablePaths); err != nil {
+			return diag.FromErr(err)
+		}
+		for _, p := range cablePaths {
+			paths = append(paths, convertCablePath(p))
+		}
+	}
+
+	result := []map[string]interface{}{}
+	for _, segments := range paths {
+		s := []map[string]interface{}{}
+		for _, segment := range segments {
+			var cableID int64
+			var cableLabel string
+			if segment.cable != nil {
+				cableID = segment.cable.ID
+				cableLabel = segment.cable.Label
+			}
+			s = append(s, map[string]interface{}{
+				"cable_id":          cableID,
+				"cable_label":       cableLabel,
+				"far_terminations":  convertCableTraceTerminations(segment.far),
+				"near_terminations": convertCableTraceTerminations(segment.near),
+			})
+		}
+		result = append(result, map[string]interface{}{"segments": s})
+	}
+
+	if err := d.Set("paths", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// convertCableTrace converts the trace of an interface, a list of
+// (near terminations, cable, far terminations) triples
+func convertCableTrace(trace [][]json.RawMessage) ([]cableTraceSegment, error) {
+	segments := []cableTraceSegment{}
+	for _, hop := range trace {
+		if len(hop) != 3 {
+			return nil, fmt.Errorf("unexpected segment of %d element(s) in the cable trace", len(hop))
+		}
+		segment := cableTraceSegment{}
+		if err := json.Unmarshal(hop[0], &segment.near); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(hop[1], &segment.cable); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(hop[2], &segment.far); err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// convertCablePath splits the nodes of a cable path, alternating the near
+// terminations, the cable and the far terminations, into segments
+func convertCablePath(p cablePath) []cableTraceSegment {
+	segments := []cableTraceSegment{}
+	for i := 0; i < len(p.Path); i += 3 {
+		segment := cableTraceSegment{near: p.Path[i]}
+		if i+1 < len(p.Path) && len(p.Path[i+1]) > 0 {
+			segment.cable = &p.Path[i+1][0]
+		}
+		if i+2 < len(p.Path) {
+			segment.far = p.Path[i+2]
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments
+}
+
+func convertCableTraceTerminations(objects []cableTraceObject) []map[string]interface{} {
+	terminations := []map[string]interface{}{}
+	for _, object := range objects {
+		var objectType string
+		if match := cableTraceObjectURL.FindStringSubmatch(object.URL); match != nil {
+			objectType = match[1] + "." + strings.TrimSuffix(strings.ReplaceAll(match[2], "-", ""), "s")
+		}
+		terminations = append(terminations, map[string]interface{}{
+			"display":     object.Display,
+			"id":          object.ID,
+			"object_type": objectType,
+		})
+	}
+
+	return terminations
+}
+
+// getCablePaths reads the cable paths of the object id at path into result.
+// The operations of the client decode a single object while Netbox returns a
+// list, so the request is built here.
+func getCablePaths(client *netboxclient.NetBoxAPI, path string, id int64,
+	result interface{}) error {
+	op := &runtime.ClientOperation{
+		ID:                 "dcim_cable_paths",
+		Method:             "GET",
+		PathPattern:        path,
+		ProducesMediaTypes: []string{runtime.JSONMime},
+		ConsumesMediaTypes: []string{runtime.JSONMime},
+		Schemes:            []string{"http"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			return r.SetPathParam("id", strconv.FormatInt(id, 10))
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse,
+			consumer runtime.Consumer) (interface{}, error) {
+			if response.Code()/100 != 2 {
+				return nil, runtime.NewAPIError("GET "+path, response.Message(), response.Code())
+			}
+			if err := consumer.Consume(response.Body(), result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		}),
+	}
+
+	_, err := client.Transport.Submit(op)
+	return err
+}
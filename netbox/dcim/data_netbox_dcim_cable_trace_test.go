@@ -0,0 +1,145 @@
+package dcim
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Trace of an interface connected to a front port, whose rear port is not
+// connected
+const testInterfaceTrace = `[
+	[
+		[{"id": 1, "url": "http://netbox/api/dcim/interfaces/1/", "display": "eth0"}],
+		{"id": 10, "url": "http://netbox/api/dcim/cables/10/", "display": "#10", "label": "uplink"},
+		[{"id": 2, "url": "http://netbox/api/dcim/front-ports/2/", "display": "FP1"}]
+	],
+	[
+		[{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/", "display": "RP1"}],
+		null,
+		[]
+	]
+]`
+
+// Path through the front port 2 and the rear port 3, ending on the front port
+// 5 of the patch panel at the other end which is not connected
+const testFrontPortPath = `{
+	"path": [
+		[{"id": 1, "url": "http://netbox/api/dcim/interfaces/1/", "display": "eth0"}],
+		[{"id": 10, "url": "http://netbox/api/dcim/cables/10/", "display": "#10", "label": "uplink"}],
+		[{"id": 2, "url": "http://netbox/api/dcim/front-ports/2/", "display": "FP1"}],
+		[{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/", "display": "RP1"}],
+		[{"id": 11, "url": "http://netbox/api/dcim/cables/11/", "display": "#11", "label": ""}],
+		[{"id": 4, "url": "http://netbox/api/dcim/rear-ports/4/", "display": "RP2"}],
+		[{"id": 5, "url": "http://netbox/api/dcim/front-ports/5/", "display": "FP2"}]
+	]
+}`
+
+func testCableTraceObject(id int64, objectType, display string) cableTraceObject {
+	return cableTraceObject{
+		ID:      id,
+		Display: display,
+		URL:     fmt.Sprintf("http://netbox/api/dcim/%s/%d/", objectType, id),
+	}
+}
+
+func TestConvertCableTrace(t *testing.T) {
+	var trace [][]json.RawMessage
+	if err := json.Unmarshal([]byte(testInterfaceTrace), &trace); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := convertCableTrace(trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []cableTraceSegment{
+		{
+			near: []cableTraceObject{testCableTraceObject(1, "interfaces", "eth0")},
+			cable: &cableTraceObject{ID: 10, Display: "#10", Label: "uplink",
+				URL: "http://netbox/api/dcim/cables/10/"},
+			far: []cableTraceObject{testCableTraceObject(2, "front-ports", "FP1")},
+		},
+		{
+			near: []cableTraceObject{testCableTraceObject(3, "rear-ports", "RP1")},
+			far:  []cableTraceObject{},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("convertCableTrace() = %+v, want %+v", got, want)
+	}
+}
+
+func TestConvertCableTraceInvalidSegment(t *testing.T) {
+	var trace [][]json.RawMessage
+	if err := json.Unmarshal([]byte(`[[[], null]]`), &trace); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := convertCableTrace(trace); err == nil {
+		t.Error("convertCableTrace() did not fail on a segment of 2 elements")
+	}
+}
+
+func TestConvertCablePath(t *testing.T) {
+	var p cablePath
+	if err := json.Unmarshal([]byte(testFrontPortPath), &p); err != nil {
+		t.Fatal(err)
+	}
+
+	got := convertCablePath(p)
+
+	want := []cableTraceSegment{
+		{
+			near: []cableTraceObject{testCableTraceObject(1, "interfaces", "eth0")},
+			cable: &cableTraceObject{ID: 10, Display: "#10", Label: "uplink",
+				URL: "http://netbox/api/dcim/cables/10/"},
+			far: []cableTraceObject{testCableTraceObject(2, "front-ports", "FP1")},
+		},
+		{
+			near: []cableTraceObject{testCableTraceObject(3, "rear-ports", "RP1")},
+			cable: &cableTraceObject{ID: 11, Display: "#11",
+				URL: "http://netbox/api/dcim/cables/11/"},
+			far: []cableTraceObject{testCableTraceObject(4, "rear-ports", "RP2")},
+		},
+		{
+			near: []cableTraceObject{testCableTraceObject(5, "front-ports", "FP2")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("convertCablePath() = %+v, want %+v", got, want)
+	}
+}
+
+func TestConvertCableTraceTerminations(t *testing.T) {
+	objects := []cableTraceObject{
+		{ID: 1, Display: "eth0", URL: "http://netbox/api/dcim/interfaces/1/"},
+		{ID: 2, Display: "FP1", URL: "http://netbox/api/dcim/front-ports/2/"},
+		{ID: 3, Display: "RP1", URL: "https://netbox/api/dcim/rear-ports/3"},
+		{ID: 4, Display: "PSU1", URL: "http://netbox/api/dcim/power-feeds/4/"},
+		{ID: 5, Display: "Side A", URL: "http://netbox/api/circuits/circuit-terminations/5/"},
+		{ID: 6, Display: "unknown", URL: "http://netbox/dcim/interfaces/6/"},
+	}
+
+	got := convertCableTraceTerminations(objects)
+
+	want := []map[string]interface{}{
+		{"display": "eth0", "id": int64(1), "object_type": "dcim.interface"},
+		{"display": "FP1", "id": int64(2), "object_type": "dcim.frontport"},
+		{"display": "RP1", "id": int64(3), "object_type": "dcim.rearport"},
+		{"display": "PSU1", "id": int64(4), "object_type": "dcim.powerfeed"},
+		{"display": "Side A", "id": int64(5), "object_type": "circuits.circuittermination"},
+		{"display": "unknown", "id": int64(6), "object_type": ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("convertCableTraceTerminations() = %v, want %v", got, want)
+	}
+}
+
+func TestConvertCableTraceTerminationsEmpty(t *testing.T) {
+	if got := convertCableTraceTerminations(nil); len(got) != 0 || got == nil {
+		t.Errorf("convertCableTraceTerminations(nil) = %#v, want an empty list", got)
+	}
+}
@@ -0,0 +1,180 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func DataNetboxDcimLocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about location (dcim module) from netbox.",
+		ReadContext: dataNetboxDcimLocationRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this location (dcim module).",
+			},
+			"custom_fields": &customfield.ComputedCustomFieldsSchema,
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of this location (dcim module) in the tree of locations of its site, 0 for a root.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this location (dcim module).",
+			},
+			"device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices in this location (dcim module) and its children.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of this location (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the parent of this location (dcim module).",
+			},
+			"parents": &parentsSchema,
+			"rack_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of racks in this location (dcim module) and its children.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the site of the location (dcim module), the slug of a location is only unique within its site.",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The slug of the location (dcim module).",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of this location (dcim module).",
+			},
+			"tag": &tag.ComputedTagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The tenant of this location (dcim module).",
+			},
+		},
+	}
+}
+
+func dataNetboxDcimLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	slug := d.Get("slug").(string)
+
+	p := dcim.NewDcimLocationsListParams().WithSlug(&slug)
+	if siteID, ok := d.GetOk("site_id"); ok {
+		siteIDStr := strconv.Itoa(siteID.(int))
+		p.SetSiteID(&siteIDStr)
+	}
+
+	list, err := client.Dcim.DcimLocationsList(p, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if *list.Payload.Count < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	} else if *list.Payload.Count > 1 {
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	r := list.Payload.Results[0]
+	d.SetId(strconv.FormatInt(r.ID, 10))
+	if err = d.Set("content_type", util.ConvertURIContentType(r.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("custom_fields", customfield.ConvertCustomFieldsFromAPIToMap(r.CustomFields)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("depth", r.Depth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", r.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("device_count", r.DeviceCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", r.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", util.GetNestedLocationID(r.Parent)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	parents, err := getParents(util.GetNestedLocationID(r.Parent), func(id int64) (map[string]interface{}, *int64, error) {
+		return readLocationParent(client, id)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parents", parents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("rack_count", r.RackCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("site_id", util.GetNestedSiteID(r.Site)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", r.Status.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(r.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(r.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readLocationParent returns the id, name and slug of the location id along with
+// the ID of its parent
+func readLocationParent(client *netboxclient.NetBoxAPI, id int64) (map[string]interface{}, *int64, error) {
+	params := dcim.NewDcimLocationsReadParams().WithID(id)
+	location, err := client.Dcim.DcimLocationsRead(params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := map[string]interface{}{
+		"id":   location.Payload.ID,
+		"name": location.Payload.Name,
+		"slug": location.Payload.Slug,
+	}
+
+	return parent, util.GetNestedLocationID(location.Payload.Parent), nil
+}
@@ -0,0 +1,233 @@
+package dcim
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+)
+
+// Number of units read in the elevation of a rack, more than the maximum
+// height of a rack so that a single page is needed
+const rackElevationPageSize int64 = 1000
+
+// Media type of the elevation of a rack rendered as SVG
+const svgMime = "image/svg+xml"
+
+type rackElevationPage struct {
+	Count   int64              `json:"count"`
+	Results []*models.RackUnit `json:"results"`
+}
+
+func DataNetboxDcimRackElevation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the elevation of a rack (dcim module) from netbox.",
+		ReadContext: dataNetboxDcimRackElevationRead,
+
+		Schema: map[string]*schema.Schema{
+			"face": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "front",
+				ValidateFunc: validation.StringInSlice([]string{"front", "rear"}, false),
+				Description:  "The face of the rack (dcim module) among \"front\" (default) or \"rear\".",
+			},
+			"free_block_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of contiguous free units looked for to compute free_block_start (1 by default).",
+			},
+			"free_block_start": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The lowest unit of the first block of free_block_size contiguous free units starting from the bottom of the rack (dcim module), 0 if there is none.",
+			},
+			"include_svg": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also get the SVG rendering of the elevation in svg.",
+			},
+			"rack_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the rack (dcim module).",
+			},
+			"svg": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SVG rendering of the elevation when include_svg is true.",
+			},
+			"units": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The units of the rack (dcim module), in the order of the elevation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the device in this unit, 0 if there is none.",
+						},
+						"device_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the device in this unit.",
+						},
+						"face": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The face of this unit.",
+						},
+						"occupied": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether this unit is occupied on this face.",
+						},
+						"unit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of this unit.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataNetboxDcimRackElevationRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	rackID := int64(d.Get("rack_id").(int))
+	face := d.Get("face").(string)
+
+	page := &rackElevationPage{}
+	if err := getRackElevation(client, rackID, face, "json", runtime.JSONMime, page); err != nil {
+		return diag.FromErr(err)
+	}
+
+	units := []map[string]interface{}{}
+	free := map[int64]bool{}
+	for _, u := range page.Results {
+		unit := map[string]interface{}{
+			"device_id":   int64(0),
+			"device_name": "",
+			"face":        face,
+			"occupied":    u.Occupied != nil && *u.Occupied,
+			"unit":        int64(u.ID),
+		}
+		if u.Face != nil && u.Face.Value != nil {
+			unit["face"] = *u.Face.Value
+		}
+		if u.Device != nil {
+			unit["device_id"] = u.Device.ID
+			if u.Device.Name != nil {
+				unit["device_name"] = *u.Device.Name
+			}
+		}
+		units = append(units, unit)
+		free[int64(u.ID)] = !unit["occupied"].(bool)
+	}
+
+	if err := d.Set("units", units); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("free_block_start", getFreeBlockStart(free, int64(d.Get("free_block_size").(int)))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var svg string
+	if d.Get("include_svg").(bool) {
+		if err := getRackElevation(client, rackID, face, "svg", svgMime, &svg); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("svg", svg); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("NetboxDcimRackElevation/%d/%s", rackID, face))
+
+	return nil
+}
+
+// getRackElevation reads the elevation of the face of the rack rackID
+// rendered as render into result. The operation of the client decodes a
+// list while Netbox returns a page of units or an SVG document, so the
+// request is built here.
+func getRackElevation(client *netboxclient.NetBoxAPI, rackID int64, face, render,
+	mediaType string, result interface{}) error {
+	path := "/dcim/racks/{id}/elevation/"
+	op := &runtime.ClientOperation{
+		ID:                 "dcim_racks_elevation_" + render,
+		Method:             "GET",
+		PathPattern:        path,
+		ProducesMediaTypes: []string{mediaType},
+		ConsumesMediaTypes: []string{runtime.JSONMime},
+		Schemes:            []string{"http"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			if err := r.SetPathParam("id", strconv.FormatInt(rackID, 10)); err != nil {
+				return err
+			}
+			if err := r.SetQueryParam("face", face); err != nil {
+				return err
+			}
+			if err := r.SetQueryParam("limit", strconv.FormatInt(rackElevationPageSize, 10)); err != nil {
+				return err
+			}
+			return r.SetQueryParam("render", render)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse,
+			consumer runtime.Consumer) (interface{}, error) {
+			if response.Code()/100 != 2 {
+				return nil, runtime.NewAPIError("GET "+path, response.Message(), response.Code())
+			}
+			if err := consumer.Consume(response.Body(), result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		}),
+	}
+
+	_, err := client.Transport.Submit(op)
+	return err
+}
+
+// getFreeBlockStart returns the lowest unit of the first block of size
+// contiguous free units starting from the bottom, 0 if there is none
+func getFreeBlockStart(free map[int64]bool, size int64) int64 {
+	units := make([]int64, 0, len(free))
+	for unit := range free {
+		units = append(units, unit)
+	}
+	sort.Slice(units, func(i, j int) bool { return units[i] < units[j] })
+
+	var start, length int64
+	for _, unit := range units {
+		if !free[unit] {
+			length = 0
+			continue
+		}
+		if length == 0 {
+			start = unit
+		}
+		length++
+		if length == size {
+			return start
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,75 @@
+package dcim
+
+import "testing"
+
+func TestGetFreeBlockStart(t *testing.T) {
+	tests := []struct {
+		name string
+		free map[int64]bool
+		size int64
+		want int64
+	}{
+		{
+			name: "empty rack",
+			free: map[int64]bool{},
+			size: 1,
+			want: 0,
+		},
+		{
+			name: "all units free",
+			free: map[int64]bool{1: true, 2: true, 3: true, 4: true},
+			size: 2,
+			want: 1,
+		},
+		{
+			name: "units are walked from the bottom",
+			free: map[int64]bool{4: true, 3: true, 2: false, 1: true, 5: true, 6: false},
+			size: 2,
+			want: 3,
+		},
+		{
+			name: "occupied unit resets the block",
+			free: map[int64]bool{1: true, 2: true, 3: false, 4: true, 5: true, 6: true},
+			size: 3,
+			want: 4,
+		},
+		{
+			name: "block fits exactly at the top",
+			free: map[int64]bool{1: false, 2: true, 3: true},
+			size: 2,
+			want: 2,
+		},
+		{
+			name: "block as large as the rack",
+			free: map[int64]bool{1: true, 2: true, 3: true},
+			size: 3,
+			want: 1,
+		},
+		{
+			name: "block larger than the rack",
+			free: map[int64]bool{1: true, 2: true, 3: true},
+			size: 4,
+			want: 0,
+		},
+		{
+			name: "no block large enough",
+			free: map[int64]bool{1: true, 2: false, 3: true, 4: false, 5: true},
+			size: 2,
+			want: 0,
+		},
+		{
+			name: "single unit",
+			free: map[int64]bool{1: false, 2: false, 3: true},
+			size: 1,
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getFreeBlockStart(tt.free, tt.size); got != tt.want {
+				t.Errorf("getFreeBlockStart() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func DataNetboxDcimRegion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about region (dcim module) from netbox.",
+		ReadContext: dataNetboxDcimRegionRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this region (dcim module).",
+			},
+			"custom_fields": &customfield.ComputedCustomFieldsSchema,
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of this region (dcim module) in the tree of regions, 0 for a root.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this region (dcim module).",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of this region (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the parent of this region (dcim module).",
+			},
+			"parents": &parentsSchema,
+			"site_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of sites in this region (dcim module) and its children.",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The slug of the region (dcim module).",
+			},
+			"tag": &tag.ComputedTagSchema,
+		},
+	}
+}
+
+func dataNetboxDcimRegionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	slug := d.Get("slug").(string)
+
+	p := dcim.NewDcimRegionsListParams().WithSlug(&slug)
+
+	list, err := client.Dcim.DcimRegionsList(p, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if *list.Payload.Count < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	} else if *list.Payload.Count > 1 {
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	r := list.Payload.Results[0]
+	d.SetId(strconv.FormatInt(r.ID, 10))
+	if err = d.Set("content_type", util.ConvertURIContentType(r.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("custom_fields", customfield.ConvertCustomFieldsFromAPIToMap(r.CustomFields)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("depth", r.Depth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", r.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", r.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", util.GetNestedRegionID(r.Parent)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	parents, err := getParents(util.GetNestedRegionID(r.Parent), func(id int64) (map[string]interface{}, *int64, error) {
+		return readRegionParent(client, id)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parents", parents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("site_count", r.SiteCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(r.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readRegionParent returns the id, name and slug of the region id along with
+// the ID of its parent
+func readRegionParent(client *netboxclient.NetBoxAPI, id int64) (map[string]interface{}, *int64, error) {
+	params := dcim.NewDcimRegionsReadParams().WithID(id)
+	region, err := client.Dcim.DcimRegionsRead(params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := map[string]interface{}{
+		"id":   region.Payload.ID,
+		"name": region.Payload.Name,
+		"slug": region.Payload.Slug,
+	}
+
+	return parent, util.GetNestedRegionID(region.Payload.Parent), nil
+}
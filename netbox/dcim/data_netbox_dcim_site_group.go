@@ -0,0 +1,143 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func DataNetboxDcimSiteGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about site group (dcim module) from netbox.",
+		ReadContext: dataNetboxDcimSiteGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this site group (dcim module).",
+			},
+			"custom_fields": &customfield.ComputedCustomFieldsSchema,
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of this site group (dcim module) in the tree of site groups, 0 for a root.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this site group (dcim module).",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of this site group (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the parent of this site group (dcim module).",
+			},
+			"parents": &parentsSchema,
+			"site_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of sites in this site group (dcim module) and its children.",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The slug of the site group (dcim module).",
+			},
+			"tag": &tag.ComputedTagSchema,
+		},
+	}
+}
+
+func dataNetboxDcimSiteGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	slug := d.Get("slug").(string)
+
+	p := dcim.NewDcimSiteGroupsListParams().WithSlug(&slug)
+
+	list, err := client.Dcim.DcimSiteGroupsList(p, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if *list.Payload.Count < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	} else if *list.Payload.Count > 1 {
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	r := list.Payload.Results[0]
+	d.SetId(strconv.FormatInt(r.ID, 10))
+	if err = d.Set("content_type", util.ConvertURIContentType(r.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("custom_fields", customfield.ConvertCustomFieldsFromAPIToMap(r.CustomFields)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("depth", r.Depth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", r.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", r.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", util.GetNestedSiteGroupID(r.Parent)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	parents, err := getParents(util.GetNestedSiteGroupID(r.Parent), func(id int64) (map[string]interface{}, *int64, error) {
+		return readSiteGroupParent(client, id)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parents", parents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("site_count", r.SiteCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(r.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readSiteGroupParent returns the id, name and slug of the site group id along with
+// the ID of its parent
+func readSiteGroupParent(client *netboxclient.NetBoxAPI, id int64) (map[string]interface{}, *int64, error) {
+	params := dcim.NewDcimSiteGroupsReadParams().WithID(id)
+	group, err := client.Dcim.DcimSiteGroupsRead(params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := map[string]interface{}{
+		"id":   group.Payload.ID,
+		"name": group.Payload.Name,
+		"slug": group.Payload.Slug,
+	}
+
+	return parent, util.GetNestedSiteGroupID(group.Payload.Parent), nil
+}
@@ -0,0 +1,431 @@
+package dcim
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+// Types of the objects a cable can be connected to
+var cableTerminationTypes = []string{
+	"circuits.circuittermination",
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.powerfeed",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+// Schema of the terminations of one end of a cable
+func cableTerminationsSchema(end string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_id": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The ID of the object connected to this end.",
+				},
+				"object_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(cableTerminationTypes, false),
+					Description:  "The type of the object connected to this end among circuits.circuittermination, dcim.consoleport, dcim.consoleserverport, dcim.frontport, dcim.interface, dcim.powerfeed, dcim.poweroutlet, dcim.powerport or dcim.rearport.",
+				},
+			},
+		},
+		Description: "The objects connected to the " + end + " end of this cable (dcim module), all of the same type.",
+	}
+}
+
+func ResourceNetboxDcimCable() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a cable (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimCableCreate,
+		ReadContext:   resourceNetboxDcimCableRead,
+		UpdateContext: resourceNetboxDcimCableUpdate,
+		DeleteContext: resourceNetboxDcimCableDelete,
+		Exists:        resourceNetboxDcimCableExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/dcim/cables/", "type"),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"a_terminations": cableTerminationsSchema("A"),
+			"b_terminations": cableTerminationsSchema("B"),
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[0-9a-f]{6}$"),
+					"^[0-9a-f]{6}$"),
+				Description: "The color of this cable (dcim module), as a 6 digits hexadecimal code.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this cable (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this cable was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+				Description:  "The label of this cable (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this cable was last updated.",
+			},
+			"length": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The length of this cable (dcim module), in length_unit.",
+			},
+			"length_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"length"},
+				ValidateFunc: validation.StringInSlice([]string{"km", "m", "cm", "mi",
+					"ft", "in"}, false),
+				Description: "The unit of the length of this cable (dcim module) among km, m, cm, mi, ft or in.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "connected",
+				ValidateFunc: validation.StringInSlice([]string{"connected", "planned",
+					"decommissioning"}, false),
+				Description: "The status among connected, planned or decommissioning (connected by default).",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The tenant of this cable (dcim module).",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of this cable (dcim module), e.g. cat6 or smf.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this cable (dcim module).",
+			},
+		},
+	}
+}
+
+var cableRequiredFields = []string{
+	"created",
+	"last_updated",
+	"a_terminations",
+	"b_terminations",
+	"tags",
+}
+
+// convertCableTerminationsToAPI converts the terminations of one end of a
+// cable from the Terraform configuration
+func convertCableTerminationsToAPI(terminations []interface{}) []*models.GenericObject {
+	objects := []*models.GenericObject{}
+	for _, t := range terminations {
+		termination := t.(map[string]interface{})
+		objectID := int64(termination["object_id"].(int))
+		objectType := termination["object_type"].(string)
+		objects = append(objects, &models.GenericObject{
+			ObjectID:   &objectID,
+			ObjectType: &objectType,
+		})
+	}
+
+	return objects
+}
+
+// convertCableTerminationsFromAPI converts the terminations of one end of a
+// cable read from Netbox
+func convertCableTerminationsFromAPI(objects []*models.GenericObject) []map[string]interface{} {
+	terminations := []map[string]interface{}{}
+	for _, object := range objects {
+		var objectID int64
+		if object.ObjectID != nil {
+			objectID = *object.ObjectID
+		}
+		terminations = append(terminations, map[string]interface{}{
+			"object_id":   objectID,
+			"object_type": stringValue(object.ObjectType),
+		})
+	}
+
+	return terminations
+}
+
+func resourceNetboxDcimCableCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	aTerminations := d.Get("a_terminations").(*schema.Set).List()
+	bTerminations := d.Get("b_terminations").(*schema.Set).List()
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	length := d.Get("length").(float64)
+	tags := d.Get("tag").(*schema.Set).List()
+	tenantID := int64(d.Get("tenant_id").(int))
+
+	newResource := &models.WritableCable{
+		ATerminations: convertCableTerminationsToAPI(aTerminations),
+		BTerminations: convertCableTerminationsToAPI(bTerminations),
+		Color:         d.Get("color").(string),
+		CustomFields:  customFields,
+		Label:         d.Get("label").(string),
+		LengthUnit:    d.Get("length_unit").(string),
+		Status:        d.Get("status").(string),
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		Type:          d.Get("type").(string),
+	}
+	if length != 0 {
+		newResource.Length = &length
+	}
+	if tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+
+	resource := dcim.NewDcimCablesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimCablesCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimCableRead(ctx, d, m)
+}
+
+func resourceNetboxDcimCableRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimCablesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimCablesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("a_terminations", convertCableTerminationsFromAPI(resource.ATerminations)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("b_terminations", convertCableTerminationsFromAPI(resource.BTerminations)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("color", resource.Color); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("length", resource.Length); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var lengthUnit string
+	if resource.LengthUnit != nil {
+		lengthUnit = stringValue(resource.LengthUnit.Value)
+	}
+	if err = d.Set("length_unit", lengthUnit); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var status string
+	if resource.Status != nil {
+		status = stringValue(resource.Status.Value)
+	}
+	if err = d.Set("status", status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("type", resource.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimCableUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableCable{}
+
+	if d.HasChange("a_terminations") {
+		aTerminations := d.Get("a_terminations").(*schema.Set).List()
+		params.ATerminations = convertCableTerminationsToAPI(aTerminations)
+	}
+	if d.HasChange("b_terminations") {
+		bTerminations := d.Get("b_terminations").(*schema.Set).List()
+		params.BTerminations = convertCableTerminationsToAPI(bTerminations)
+	}
+	if d.HasChange("color") {
+		color := d.Get("color").(string)
+		params.Color = color
+		modifiedFields["color"] = color
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("length") {
+		length := d.Get("length").(float64)
+		params.Length = &length
+		modifiedFields["length"] = length
+	}
+	if d.HasChange("length_unit") {
+		lengthUnit := d.Get("length_unit").(string)
+		params.LengthUnit = lengthUnit
+		modifiedFields["length_unit"] = lengthUnit
+	}
+	if d.HasChange("status") {
+		params.Status = d.Get("status").(string)
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+	if d.HasChange("type") {
+		cableType := d.Get("type").(string)
+		params.Type = cableType
+		modifiedFields["type"] = cableType
+	}
+
+	resource := dcim.NewDcimCablesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimCablesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, cableRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimCableRead(ctx, d, m)
+}
+
+func resourceNetboxDcimCableDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimCableExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimCablesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimCablesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimCableExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimCablesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimCablesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,179 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimCable = "netbox_dcim_cable.test"
+
+func TestAccNetboxDcimCableMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimCableConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimCable),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimCable,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimCableFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimCableConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimCable),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimCable,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimCableMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimCableConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimCable),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimCableConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimCable),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimCableConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimCable),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimCableConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimCable),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimCableConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "test1" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth0"
+		type      = "1000base-t"
+	}
+
+	resource "netbox_dcim_interface" "test2" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth1"
+		type      = "1000base-t"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_tenancy_tenant" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_cable" "test" {
+		a_terminations {
+			object_type = "dcim.interface"
+			object_id   = netbox_dcim_interface.test1.id
+		}
+
+		b_terminations {
+			object_type = "dcim.interface"
+			object_id   = netbox_dcim_interface.test2.id
+		}
+		{{ if eq .resourcefull "true" }}
+		color       = "2196f3"
+		label       = "Test cable"
+		length      = 2.5
+		length_unit = "m"
+		status      = "planned"
+		tenant_id   = netbox_tenancy_tenant.test.id
+		type        = "cat6"
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
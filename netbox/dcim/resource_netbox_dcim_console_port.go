@@ -0,0 +1,383 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+// Speeds of the console ports and console server ports in bps
+var consolePortSpeeds = []int{1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200}
+
+func ResourceNetboxDcimConsolePort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a console port of a device (dcim module) within Netbox. A console port created on the device from the templates of its device type or of the module type of one of its modules is adopted, its fields are overwritten. Any other existing console port with the same name is not adopted.",
+		CreateContext: resourceNetboxDcimConsolePortCreate,
+		ReadContext:   resourceNetboxDcimConsolePortRead,
+		UpdateContext: resourceNetboxDcimConsolePortUpdate,
+		DeleteContext: resourceNetboxDcimConsolePortDelete,
+		Exists:        resourceNetboxDcimConsolePortExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/dcim/console-ports/", "type"),
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("console port", listConsolePortIDs),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this console port (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this console port was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this console port (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this console port (dcim module).",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this console port (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this console port was last updated.",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this console port (dcim module) is considered connected without a cable.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this console port (dcim module).",
+			},
+			"speed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(consolePortSpeeds),
+				Description:  "The speed of this console port (dcim module) in bps, from 1200 to 115200.",
+			},
+			"tag": &tag.TagSchema,
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of this console port (dcim module), e.g. rj-45 or usb-c.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this console port (dcim module).",
+			},
+		},
+	}
+}
+
+var consolePortRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"name",
+	"tags",
+}
+
+// listConsolePortIDs returns the IDs of the console ports named name of the
+// device whose ID is deviceID or whose name is device
+func listConsolePortIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimConsolePortsListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimConsolePortsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimConsolePortCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	label := d.Get("label").(string)
+	markConnected := d.Get("mark_connected").(bool)
+	name := d.Get("name").(string)
+	speed := int64(d.Get("speed").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	consolePortType := d.Get("type").(string)
+
+	newResource := &models.WritableConsolePort{
+		CustomFields:  customFields,
+		Description:   description,
+		Device:        &deviceID,
+		Label:         label,
+		MarkConnected: markConnected,
+		Name:          &name,
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		Type:          consolePortType,
+	}
+	if speed != 0 {
+		newResource.Speed = &speed
+	}
+
+	// The console ports created from the templates of the device type or of its
+	// modules are adopted, all their fields are overwritten
+	resourceID, err := templatedComponentID(client, consolePortTemplateKind, listConsolePortIDs, deviceID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resourceID != 0 {
+		modifiedFields := map[string]interface{}{
+			"description":    description,
+			"label":          label,
+			"mark_connected": markConnected,
+			"speed":          speed,
+			"type":           consolePortType,
+		}
+
+		resource := dcim.NewDcimConsolePortsPartialUpdateParams().WithID(resourceID).WithData(newResource)
+		if _, err := client.Dcim.DcimConsolePortsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, consolePortRequiredFields)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.FormatInt(resourceID, 10))
+
+		return resourceNetboxDcimConsolePortRead(ctx, d, m)
+	}
+
+	resource := dcim.NewDcimConsolePortsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimConsolePortsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimConsolePortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimConsolePortRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimConsolePortsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimConsolePortsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mark_connected", resource.MarkConnected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var speed *int64
+	if resource.Speed != nil {
+		speed = resource.Speed.Value
+	}
+	if err = d.Set("speed", speed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var consolePortType string
+	if resource.Type != nil {
+		consolePortType = stringValue(resource.Type.Value)
+	}
+	if err = d.Set("type", consolePortType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableConsolePort{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("mark_connected") {
+		markConnected := d.Get("mark_connected").(bool)
+		params.MarkConnected = markConnected
+		modifiedFields["mark_connected"] = markConnected
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("speed") {
+		speed := int64(d.Get("speed").(int))
+		params.Speed = &speed
+		modifiedFields["speed"] = speed
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("type") {
+		consolePortType := d.Get("type").(string)
+		params.Type = consolePortType
+		modifiedFields["type"] = consolePortType
+	}
+
+	resource := dcim.NewDcimConsolePortsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimConsolePortsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, consolePortRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimConsolePortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimConsolePortDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimConsolePortExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimConsolePortsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimConsolePortsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimConsolePortsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimConsolePortsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
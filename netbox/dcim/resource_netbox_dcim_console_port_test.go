@@ -0,0 +1,164 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimConsolePort = "netbox_dcim_console_port.test"
+
+func TestAccNetboxDcimConsolePortMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsolePort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimConsolePortFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsolePort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsolePort,
+				ImportState:       true,
+				ImportStateId:     "test-" + nameSuffix + "/con0",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimConsolePortMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsolePortConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsolePort),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimConsolePortConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+
+		console_port_template {
+			name = "con0"
+			type = "rj-45"
+		}
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_console_port" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "con0"
+		{{ if eq .resourcefull "true" }}
+		description    = "Test console port"
+		label          = "Console"
+		mark_connected = true
+		speed          = 9600
+		type           = "rj-45"
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
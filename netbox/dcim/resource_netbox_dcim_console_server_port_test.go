@@ -0,0 +1,159 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimConsoleServerPort = "netbox_dcim_console_server_port.test"
+
+func TestAccNetboxDcimConsoleServerPortMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsoleServerPort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsoleServerPort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimConsoleServerPortFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsoleServerPort),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsoleServerPort,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimConsoleServerPort,
+				ImportState:       true,
+				ImportStateId:     "test-" + nameSuffix + "/ttyS0",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimConsoleServerPortMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsoleServerPort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsoleServerPort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsoleServerPort),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimConsoleServerPort),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimConsoleServerPortConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_console_server_port" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "ttyS0"
+		{{ if eq .resourcefull "true" }}
+		description    = "Test console server port"
+		label          = "Line 1"
+		mark_connected = true
+		speed          = 115200
+		type           = "rj-45"
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,700 @@
+package dcim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimDevice() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a device (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimDeviceCreate,
+		ReadContext:   resourceNetboxDcimDeviceRead,
+		UpdateContext: resourceNetboxDcimDeviceUpdate,
+		DeleteContext: resourceNetboxDcimDeviceDelete,
+		Exists:        resourceNetboxDcimDeviceExists,
+		CustomizeDiff: resourceNetboxDcimDeviceCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"airflow": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"front-to-rear", "rear-to-front",
+					"left-to-right", "right-to-left", "side-to-rear", "passive", "mixed"}, false),
+				Description: "The airflow of this device (dcim module).",
+			},
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+				Description:  "The asset tag of this device (dcim module), unique in Netbox.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The cluster of this device (dcim module).",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments for this device (dcim module).",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this device (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The device type of this device (dcim module).",
+			},
+			"face": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"front", "rear"}, false),
+				Description:  "The face of the rack this device (dcim module) is mounted on among front or rear.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device was last updated.",
+			},
+			"local_context_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Local context data for this device (dcim module).",
+			},
+			"location_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The location of this device (dcim module).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this device (dcim module).",
+			},
+			"platform_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The platform of this device (dcim module).",
+			},
+			"position": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"face", "rack_id"},
+				ValidateFunc: validation.All(validation.FloatAtLeast(1), validateWholeUnit),
+				Description:  "The lowest unit of the rack occupied by this device (dcim module), half-unit positions are not supported.",
+			},
+			"rack_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The rack of this device (dcim module).",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The device role of this device (dcim module).",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The serial number of this device (dcim module).",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The site of this device (dcim module).",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice([]string{"offline", "active",
+					"planned", "staged", "failed", "inventory", "decommissioning"}, false),
+				Description: "The status among offline, active, planned, staged, failed, inventory or decommissioning (active by default).",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The tenant of this device (dcim module).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this device (dcim module).",
+			},
+			"vc_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"virtual_chassis_id"},
+				ValidateFunc: validation.IntBetween(0, 255),
+				Description:  "The position of this device (dcim module) in its virtual chassis.",
+			},
+			"vc_priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"virtual_chassis_id"},
+				ValidateFunc: validation.IntBetween(0, 255),
+				Description:  "The priority of this device (dcim module) to become the master of its virtual chassis.",
+			},
+			"virtual_chassis_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The virtual chassis of this device (dcim module).",
+			},
+		},
+	}
+}
+
+var deviceRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device_role",
+	"device_type",
+	"face",
+	"name",
+	"rack",
+	"site",
+	"tags",
+	"tenant",
+	"virtual_chassis",
+}
+
+// resourceNetboxDcimDeviceCustomizeDiff checks that the units of the rack
+// needed by the device are free so that the plan fails instead of the apply
+func resourceNetboxDcimDeviceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	client, ok := m.(*netboxclient.NetBoxAPI)
+	if !ok {
+		return nil
+	}
+
+	for _, key := range []string{"device_type_id", "face", "position", "rack_id"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	if d.Id() != "" && !d.HasChanges("device_type_id", "face", "position", "rack_id") {
+		return nil
+	}
+
+	position := d.Get("position").(float64)
+	rackID := int64(d.Get("rack_id").(int))
+	if position == 0 || rackID == 0 {
+		return nil
+	}
+
+	var deviceID int64
+	if d.Id() != "" {
+		id, err := strconv.ParseInt(d.Id(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("Unable to convert ID into int64")
+		}
+		deviceID = id
+	}
+
+	return checkDevicePlacement(client, int64(d.Get("device_type_id").(int)), rackID,
+		d.Get("face").(string), position, deviceID)
+}
+
+// validateWholeUnit checks that a position in a rack is a whole unit,
+// half-unit positions are not supported
+func validateWholeUnit(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(float64)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be float", k)}
+	}
+
+	if v != math.Trunc(v) {
+		return nil, []error{fmt.Errorf("expected %s to be a whole unit, half-unit positions are not supported, got %v", k, v)}
+	}
+
+	return nil, nil
+}
+
+// checkDevicePlacement returns an error when a device of the device type
+// deviceTypeID can't be mounted at position on the face of the rack rackID.
+// The units occupied by the device deviceID are considered free.
+func checkDevicePlacement(client *netboxclient.NetBoxAPI, deviceTypeID, rackID int64,
+	face string, position float64, deviceID int64) error {
+	params := dcim.NewDcimDeviceTypesReadParams().WithID(deviceTypeID)
+	deviceType, err := client.Dcim.DcimDeviceTypesRead(params, nil)
+	if err != nil {
+		return err
+	}
+
+	var uHeight float64
+	if deviceType.Payload.UHeight != nil {
+		uHeight = *deviceType.Payload.UHeight
+	}
+	if uHeight == 0 {
+		return fmt.Errorf("a device of the 0U device type %d can't have a position in a rack",
+			deviceTypeID)
+	}
+
+	faces := []string{face}
+	if deviceType.Payload.IsFullDepth {
+		faces = []string{"front", "rear"}
+	}
+
+	for _, f := range faces {
+		page := &rackElevationPage{}
+		if err := getRackElevation(client, rackID, f, "json", runtime.JSONMime, page); err != nil {
+			return err
+		}
+
+		units := map[float64]*models.RackUnit{}
+		for _, u := range page.Results {
+			units[u.ID] = u
+		}
+
+		for unit := position; unit < position+uHeight; unit++ {
+			u, ok := units[unit]
+			if !ok {
+				return fmt.Errorf("a device of %v U at position %v doesn't fit in rack %d",
+					uHeight, position, rackID)
+			}
+			if u.Occupied == nil || !*u.Occupied {
+				continue
+			}
+			if u.Device != nil && u.Device.ID == deviceID {
+				continue
+			}
+
+			occupant := "a reservation"
+			if u.Device != nil {
+				occupant = fmt.Sprintf("device %d", u.Device.ID)
+				if u.Device.Name != nil {
+					occupant = fmt.Sprintf("device %s", *u.Device.Name)
+				}
+			}
+			return fmt.Errorf("unit %v on the %s face of rack %d needed by a device of %v U at position %v is occupied by %s",
+				unit, f, rackID, uHeight, position, occupant)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	assetTag := d.Get("asset_tag").(string)
+	clusterID := int64(d.Get("cluster_id").(int))
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	face := d.Get("face").(string)
+	localContextData := d.Get("local_context_data").(string)
+	locationID := int64(d.Get("location_id").(int))
+	name := d.Get("name").(string)
+	platformID := int64(d.Get("platform_id").(int))
+	position := d.Get("position").(float64)
+	rackID := int64(d.Get("rack_id").(int))
+	roleID := int64(d.Get("role_id").(int))
+	siteID := int64(d.Get("site_id").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	tenantID := int64(d.Get("tenant_id").(int))
+	vcPosition := int64(d.Get("vc_position").(int))
+	vcPriority := int64(d.Get("vc_priority").(int))
+	virtualChassisID := int64(d.Get("virtual_chassis_id").(int))
+
+	newResource := &models.WritableDeviceWithConfigContext{
+		Airflow:      d.Get("airflow").(string),
+		Comments:     d.Get("comments").(string),
+		CustomFields: customFields,
+		DeviceRole:   &roleID,
+		DeviceType:   &deviceTypeID,
+		Serial:       d.Get("serial").(string),
+		Site:         &siteID,
+		Status:       d.Get("status").(string),
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+	if assetTag != "" {
+		newResource.AssetTag = &assetTag
+	}
+	if clusterID != 0 {
+		newResource.Cluster = &clusterID
+	}
+	if face != "" {
+		newResource.Face = &face
+	}
+	if localContextData != "" {
+		var localContextDataMap map[string]*interface{}
+		if err := json.Unmarshal([]byte(localContextData), &localContextDataMap); err != nil {
+			return diag.FromErr(err)
+		}
+		newResource.LocalContextData = localContextDataMap
+	}
+	if locationID != 0 {
+		newResource.Location = &locationID
+	}
+	if name != "" {
+		newResource.Name = &name
+	}
+	if platformID != 0 {
+		newResource.Platform = &platformID
+	}
+	if position != 0 {
+		newResource.Position = &position
+	}
+	if rackID != 0 {
+		newResource.Rack = &rackID
+	}
+	if tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+	if virtualChassisID != 0 {
+		newResource.VirtualChassis = &virtualChassisID
+		newResource.VcPosition = &vcPosition
+		newResource.VcPriority = &vcPriority
+	}
+
+	resource := dcim.NewDcimDevicesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimDevicesCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimDeviceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimDevicesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	var airflow string
+	if resource.Airflow != nil {
+		airflow = stringValue(resource.Airflow.Value)
+	}
+	if err = d.Set("airflow", airflow); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("asset_tag", resource.AssetTag); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("cluster_id", util.GetNestedClusterID(resource.Cluster)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comments", resource.Comments); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("device_type_id", util.GetNestedDeviceTypeID(resource.DeviceType)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var face string
+	if resource.Face != nil {
+		face = stringValue(resource.Face.Value)
+	}
+	if err = d.Set("face", face); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	localContextDataJSON, err := util.GetLocalContextData(resource.LocalContextData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("local_context_data", localContextDataJSON); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("location_id", util.GetNestedLocationID(resource.Location)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("platform_id", util.GetNestedPlatformID(resource.Platform)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("position", resource.Position); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rack_id", util.GetNestedRackID(resource.Rack)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role_id", util.GetNestedRoleID(resource.DeviceRole)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("serial", resource.Serial); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("site_id", util.GetNestedSiteID(resource.Site)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", resource.Status.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("vc_position", resource.VcPosition); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("vc_priority", resource.VcPriority); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("virtual_chassis_id", util.GetNestedVirtualChassisID(resource.VirtualChassis)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableDeviceWithConfigContext{}
+
+	if d.HasChange("airflow") {
+		airflow := d.Get("airflow").(string)
+		params.Airflow = airflow
+		modifiedFields["airflow"] = airflow
+	}
+	if d.HasChange("asset_tag") {
+		assetTag := d.Get("asset_tag").(string)
+		if assetTag != "" {
+			params.AssetTag = &assetTag
+		} else {
+			modifiedFields["asset_tag"] = nil
+		}
+	}
+	if d.HasChange("cluster_id") {
+		clusterID := int64(d.Get("cluster_id").(int))
+		params.Cluster = &clusterID
+		modifiedFields["cluster"] = clusterID
+	}
+	if d.HasChange("comments") {
+		comments := d.Get("comments").(string)
+		params.Comments = comments
+		modifiedFields["comments"] = comments
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("device_type_id") {
+		deviceTypeID := int64(d.Get("device_type_id").(int))
+		params.DeviceType = &deviceTypeID
+	}
+	if d.HasChange("face") {
+		face := d.Get("face").(string)
+		params.Face = &face
+		modifiedFields["face"] = face
+	}
+	if d.HasChange("local_context_data") {
+		localContextData := d.Get("local_context_data").(string)
+		if localContextData != "" {
+			var localContextDataMap map[string]*interface{}
+			if err := json.Unmarshal([]byte(localContextData), &localContextDataMap); err != nil {
+				return diag.FromErr(err)
+			}
+			params.LocalContextData = localContextDataMap
+		} else {
+			modifiedFields["local_context_data"] = nil
+		}
+	}
+	if d.HasChange("location_id") {
+		locationID := int64(d.Get("location_id").(int))
+		params.Location = &locationID
+		modifiedFields["location"] = locationID
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		if name != "" {
+			params.Name = &name
+		} else {
+			modifiedFields["name"] = nil
+		}
+	}
+	if d.HasChange("platform_id") {
+		platformID := int64(d.Get("platform_id").(int))
+		params.Platform = &platformID
+		modifiedFields["platform"] = platformID
+	}
+	if d.HasChange("position") {
+		position := d.Get("position").(float64)
+		params.Position = &position
+		modifiedFields["position"] = position
+	}
+	if d.HasChange("rack_id") {
+		rackID := int64(d.Get("rack_id").(int))
+		params.Rack = &rackID
+		modifiedFields["rack"] = rackID
+	}
+	if d.HasChange("role_id") {
+		roleID := int64(d.Get("role_id").(int))
+		params.DeviceRole = &roleID
+	}
+	if d.HasChange("serial") {
+		serial := d.Get("serial").(string)
+		params.Serial = serial
+		modifiedFields["serial"] = serial
+	}
+	if d.HasChange("site_id") {
+		siteID := int64(d.Get("site_id").(int))
+		params.Site = &siteID
+	}
+	if d.HasChange("status") {
+		params.Status = d.Get("status").(string)
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+	if d.HasChange("vc_position") {
+		vcPosition := int64(d.Get("vc_position").(int))
+		params.VcPosition = &vcPosition
+		modifiedFields["vc_position"] = vcPosition
+	}
+	if d.HasChange("vc_priority") {
+		vcPriority := int64(d.Get("vc_priority").(int))
+		params.VcPriority = &vcPriority
+		modifiedFields["vc_priority"] = vcPriority
+	}
+	if d.HasChange("virtual_chassis_id") {
+		virtualChassisID := int64(d.Get("virtual_chassis_id").(int))
+		params.VirtualChassis = &virtualChassisID
+		modifiedFields["virtual_chassis"] = virtualChassisID
+	}
+
+	resource := dcim.NewDcimDevicesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimDevicesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, deviceRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimDeviceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimDeviceExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimDevicesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimDevicesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimDevicesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
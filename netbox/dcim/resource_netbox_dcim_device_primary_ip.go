@@ -0,0 +1,286 @@
+package dcim
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+)
+
+func ResourceNetboxDcimDevicePrimaryIP() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a primary ip assignment for a device (dcim module) resource within Netbox.",
+		CreateContext: resourceNetboxDcimDevicePrimaryIPCreate,
+		ReadContext:   resourceNetboxDcimDevicePrimaryIPRead,
+		UpdateContext: resourceNetboxDcimDevicePrimaryIPUpdate,
+		DeleteContext: resourceNetboxDcimDevicePrimaryIPDelete,
+		Exists:        resourceNetboxDcimDevicePrimaryIPExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the device.",
+			},
+			"primary_ip4_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the primary IPv4 address, it must be assigned to an interface of the device.",
+			},
+			"primary_ip6_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the primary IPv6 address, it must be assigned to an interface of the device.",
+			},
+		},
+	}
+}
+
+// The fields of a device not sent when only its primary IPs are updated
+var devicePrimaryIPDropFields = []string{
+	"created",
+	"last_updated",
+	"device_role",
+	"device_type",
+	"face",
+	"name",
+	"rack",
+	"site",
+	"tags",
+	"tenant",
+	"virtual_chassis",
+}
+
+func resourceNetboxDcimDevicePrimaryIPCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimDevicePrimaryIPExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return diag.Errorf("device with ID %d does not exist", d.Get("device_id"))
+	}
+
+	emptyFields := make(map[string]interface{})
+
+	deviceID := int64(d.Get("device_id").(int))
+
+	newResource := &models.WritableDeviceWithConfigContext{}
+	if primaryIP4ID := int64(d.Get("primary_ip4_id").(int)); primaryIP4ID != 0 {
+		if err := checkDevicePrimaryIP(client, deviceID, primaryIP4ID, 4); err != nil {
+			return diag.FromErr(err)
+		}
+		newResource.PrimaryIp4 = &primaryIP4ID
+	}
+	if primaryIP6ID := int64(d.Get("primary_ip6_id").(int)); primaryIP6ID != 0 {
+		if err := checkDevicePrimaryIP(client, deviceID, primaryIP6ID, 6); err != nil {
+			return diag.FromErr(err)
+		}
+		newResource.PrimaryIp6 = &primaryIP6ID
+	}
+
+	resource := dcim.NewDcimDevicesPartialUpdateParams().WithData(newResource).WithID(deviceID)
+
+	resourceCreated, err := client.Dcim.DcimDevicesPartialUpdate(resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, devicePrimaryIPDropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimDevicePrimaryIPRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDevicePrimaryIPRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimDevicesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+
+			// Setting this is only needed for imported resources
+			if err := d.Set("device_id", resource.ID); err != nil {
+				return diag.FromErr(err)
+			}
+
+			var primaryIP4ID *int64
+			primaryIP4ID = nil
+			if resource.PrimaryIp4 != nil {
+				primaryIP4ID = &resource.PrimaryIp4.ID
+			}
+			if err := d.Set("primary_ip4_id", primaryIP4ID); err != nil {
+				return diag.FromErr(err)
+			}
+
+			var primaryIP6ID *int64
+			primaryIP6ID = nil
+			if resource.PrimaryIp6 != nil {
+				primaryIP6ID = &resource.PrimaryIp6.ID
+			}
+			if err := d.Set("primary_ip6_id", primaryIP6ID); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNetboxDcimDevicePrimaryIPUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimDevicePrimaryIPExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return diag.Errorf("device with ID %d does not exist", d.Get("device_id"))
+	}
+
+	emptyFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	params := &models.WritableDeviceWithConfigContext{}
+
+	if d.HasChange("primary_ip4_id") {
+		primaryIP4ID := int64(d.Get("primary_ip4_id").(int))
+		params.PrimaryIp4 = &primaryIP4ID
+		if primaryIP4ID == 0 {
+			emptyFields["primary_ip4"] = nil
+		} else if err := checkDevicePrimaryIP(client, resourceID, primaryIP4ID, 4); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("primary_ip6_id") {
+		primaryIP6ID := int64(d.Get("primary_ip6_id").(int))
+		params.PrimaryIp6 = &primaryIP6ID
+		if primaryIP6ID == 0 {
+			emptyFields["primary_ip6"] = nil
+		} else if err := checkDevicePrimaryIP(client, resourceID, primaryIP6ID, 6); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resource := dcim.NewDcimDevicesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimDevicesPartialUpdate(
+		resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, devicePrimaryIPDropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimDevicePrimaryIPRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDevicePrimaryIPDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimDevicePrimaryIPExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	emptyFields := map[string]interface{}{
+		"primary_ip4": nil,
+		"primary_ip6": nil,
+	}
+
+	params := &models.WritableDeviceWithConfigContext{}
+
+	resource := dcim.NewDcimDevicesPartialUpdateParams().WithData(params)
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimDevicesPartialUpdate(
+		resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, devicePrimaryIPDropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDevicePrimaryIPExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := int64(d.Get("device_id").(int))
+	resourceIDString := strconv.FormatInt(resourceID, 10)
+	params := dcim.NewDcimDevicesListParams().WithID(&resourceIDString)
+	resources, err := client.Dcim.DcimDevicesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if resource.ID == resourceID {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
+
+// checkDevicePrimaryIP checks that the IP address ipID of the given family is
+// assigned to an interface of the device deviceID
+func checkDevicePrimaryIP(client *netboxclient.NetBoxAPI, deviceID, ipID int64,
+	family float64) error {
+	deviceIDString := strconv.FormatInt(deviceID, 10)
+	ipIDString := strconv.FormatInt(ipID, 10)
+	params := ipam.NewIpamIPAddressesListParams().WithID(&ipIDString).WithDeviceID(
+		&deviceIDString).WithFamily(&family)
+	resources, err := client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	if *resources.Payload.Count != 1 {
+		return fmt.Errorf("IP address with ID %d is not an IPv%.0f address assigned to an interface of the device with ID %d",
+			ipID, family, deviceID)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDevicePrimaryIP = "netbox_dcim_device_primary_ip.test"
+
+func TestAccNetboxDcimDevicePrimaryIPMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix, false, false, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevicePrimaryIP),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDevicePrimaryIP,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDevicePrimaryIPFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix, true, true, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevicePrimaryIP),
+					resource.TestCheckResourceAttrPair(resourceNameNetboxDcimDevicePrimaryIP, "primary_ip4_id", "netbox_ipam_ip_addresses.test4", "id"),
+					resource.TestCheckResourceAttrPair(resourceNameNetboxDcimDevicePrimaryIP, "primary_ip6_id", "netbox_ipam_ip_addresses.test6", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDevicePrimaryIP,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDevicePrimaryIPMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix, false, false, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevicePrimaryIP),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix, true, true, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevicePrimaryIP),
+					resource.TestCheckResourceAttrPair(resourceNameNetboxDcimDevicePrimaryIP, "primary_ip4_id", "netbox_ipam_ip_addresses.test4", "id"),
+					resource.TestCheckResourceAttrPair(resourceNameNetboxDcimDevicePrimaryIP, "primary_ip6_id", "netbox_ipam_ip_addresses.test6", "id"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix, false, true, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevicePrimaryIP),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix, false, false, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevicePrimaryIP),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDevicePrimaryIPConfig(nameSuffix string, resourceFull, extraResources bool, ipnum int64) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth0"
+		type      = "1000base-t"
+	}
+
+	resource "netbox_ipam_ip_addresses" "test4" {
+		address     = "${cidrhost("10.0.0.0/8", {{ .ipnum }})}/24"
+		object_id   = netbox_dcim_interface.test.id
+		object_type = "dcim.interface"
+	}
+
+	resource "netbox_ipam_ip_addresses" "test6" {
+		address     = "${cidrhost("2001:db8::/32", {{ .ipnum }})}/64"
+		object_id   = netbox_dcim_interface.test.id
+		object_type = "dcim.interface"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_device_primary_ip" "test" {
+		device_id = netbox_dcim_device.test.id
+		{{ if eq .resourcefull "true" }}
+		primary_ip4_id = netbox_ipam_ip_addresses.test4.id
+		primary_ip6_id = netbox_ipam_ip_addresses.test6.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+		"ipnum":          strconv.FormatInt(ipnum, 10),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,203 @@
+package dcim_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDevice = "netbox_dcim_device.test"
+
+func TestAccNetboxDcimDeviceMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDevice,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDevice,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDevicePlacementConflict(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDevice),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceConfig(nameSuffix, true, true) + `
+				resource "netbox_dcim_device" "conflict" {
+					name           = "conflict-` + nameSuffix + `"
+					device_type_id = netbox_dcim_device_type.test.id
+					role_id        = netbox_dcim_device_role.test.id
+					site_id        = netbox_dcim_site.test.id
+					rack_id        = netbox_dcim_rack.test.id
+					face           = "front"
+					position       = 11
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is occupied by device"),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+		u_height        = 2
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_dcim_rack" "test" {
+		name    = "test-{{ .namesuffix }}"
+		site_id = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_platform" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_tenancy_tenant" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_device" "test" {
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+		{{ if eq .resourcefull "true" }}
+		airflow            = "front-to-rear"
+		asset_tag          = "test-{{ .namesuffix }}"
+		comments           = "Test device"
+		face               = "front"
+		local_context_data = jsonencode({ "context_data" = "value" })
+		name               = "test-{{ .namesuffix }}"
+		platform_id        = netbox_dcim_platform.test.id
+		position           = 10
+		rack_id            = netbox_dcim_rack.test.id
+		serial             = "test-{{ .namesuffix }}"
+		status             = "planned"
+		tenant_id          = netbox_tenancy_tenant.test.id
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,546 @@
+package dcim
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+	"gopkg.in/yaml.v3"
+)
+
+// Component templates of a device type, the referenced kinds come first
+var deviceTypeTemplateKinds = []*componentTemplateKind{
+	consolePortTemplateKind,
+	consoleServerPortTemplateKind,
+	powerPortTemplateKind,
+	powerOutletTemplateKind,
+	interfaceTemplateKind,
+	rearPortTemplateKind,
+	frontPortTemplateKind,
+	deviceBayTemplateKind,
+	moduleBayTemplateKind,
+}
+
+// Attributes of a device type defined by library_yaml along with their
+// value when they are not set
+var deviceTypeLibraryFields = map[string]interface{}{
+	"airflow":        "",
+	"comments":       "",
+	"is_full_depth":  true,
+	"model":          nil,
+	"part_number":    "",
+	"slug":           nil,
+	"subdevice_role": "",
+	"u_height":       1.0,
+}
+
+func ResourceNetboxDcimDeviceType() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a device type (dcim module) and its component templates within Netbox.",
+		CreateContext: resourceNetboxDcimDeviceTypeCreate,
+		ReadContext:   resourceNetboxDcimDeviceTypeRead,
+		UpdateContext: resourceNetboxDcimDeviceTypeUpdate,
+		DeleteContext: resourceNetboxDcimDeviceTypeDelete,
+		Exists:        resourceNetboxDcimDeviceTypeExists,
+		CustomizeDiff: resourceNetboxDcimDeviceTypeCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"airflow": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{"front-to-rear", "rear-to-front",
+					"left-to-right", "right-to-left", "side-to-rear", "passive", "mixed"}, false),
+				Description: "The airflow of this device type (dcim module).",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Comments for this device type (dcim module).",
+			},
+			"console_port_template":        consolePortTemplateKind.schema("The console port templates of this device type (dcim module)."),
+			"console_server_port_template": consoleServerPortTemplateKind.schema("The console server port templates of this device type (dcim module)."),
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this device type (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device type was created.",
+			},
+			"custom_field":        &customfield.CustomFieldSchema,
+			"device_bay_template": deviceBayTemplateKind.schema("The device bay templates of this device type (dcim module)."),
+			"device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices of this device type (dcim module).",
+			},
+			"front_port_template": frontPortTemplateKind.schema("The front port templates of this device type (dcim module)."),
+			"interface_template":  interfaceTemplateKind.schema("The interface templates of this device type (dcim module)."),
+			"is_full_depth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether devices of this device type (dcim module) consume both front and rear rack faces (true by default).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this device type was last updated.",
+			},
+			"library_yaml": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ConflictsWith: []string{"airflow", "comments", "console_port_template",
+					"console_server_port_template", "device_bay_template", "front_port_template",
+					"interface_template", "is_full_depth", "model", "module_bay_template",
+					"part_number", "power_outlet_template", "power_port_template",
+					"rear_port_template", "slug", "subdevice_role", "u_height"},
+				Description: "The content of a YAML file of the devicetype-library (https://github.com/netbox-community/devicetype-library) defining this device type (dcim module) and its component templates. The manufacturer of the file is ignored and the attributes it defines can't be set.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The manufacturer of this device type (dcim module).",
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The model of this device type (dcim module), required without library_yaml.",
+			},
+			"module_bay_template": moduleBayTemplateKind.schema("The module bay templates of this device type (dcim module)."),
+			"part_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The part number of this device type (dcim module).",
+			},
+			"power_outlet_template": powerOutletTemplateKind.schema("The power outlet templates of this device type (dcim module)."),
+			"power_port_template":   powerPortTemplateKind.schema("The power port templates of this device type (dcim module)."),
+			"rear_port_template":    rearPortTemplateKind.schema("The rear port templates of this device type (dcim module)."),
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The slug of this device type (dcim module), required without library_yaml.",
+			},
+			"subdevice_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"parent", "child"}, false),
+				Description:  "The role of this device type (dcim module) in the device bays among parent or child.",
+			},
+			"tag": &tag.TagSchema,
+			"u_height": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The height in rack units of this device type (dcim module), 1 by default.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this device type (dcim module).",
+			},
+		},
+	}
+}
+
+var deviceTypeRequiredFields = []string{
+	"created",
+	"last_updated",
+	"manufacturer",
+	"model",
+	"slug",
+	"tags",
+}
+
+// resourceNetboxDcimDeviceTypeCustomizeDiff plans the attributes defined by
+// library_yaml. Without it, the attributes which are not set get their
+// default value so that removing them from the configuration resets them.
+func resourceNetboxDcimDeviceTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	keys := []string{}
+	for key := range deviceTypeLibraryFields {
+		keys = append(keys, key)
+	}
+	for _, kind := range deviceTypeTemplateKinds {
+		keys = append(keys, kind.key)
+	}
+
+	if !d.NewValueKnown("library_yaml") {
+		for _, key := range keys {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if libraryYAML := d.Get("library_yaml").(string); libraryYAML != "" {
+		values, err := parseDeviceTypeLibrary(libraryYAML)
+		if err != nil {
+			return fmt.Errorf("library_yaml: %w", err)
+		}
+		for _, key := range keys {
+			if err := d.SetNew(key, values[key]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+	for key, value := range deviceTypeLibraryFields {
+		if !config.GetAttr(key).IsNull() {
+			continue
+		}
+		if value == nil {
+			return fmt.Errorf("%s is required when library_yaml is not set", key)
+		}
+		if err := d.SetNew(key, value); err != nil {
+			return err
+		}
+	}
+
+	return planEmptyComponentTemplates(d, deviceTypeTemplateKinds)
+}
+
+// parseDeviceTypeLibrary returns the values of the attributes defined by a
+// YAML file of the devicetype-library
+func parseDeviceTypeLibrary(content string) (map[string]interface{}, error) {
+	library := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(content), &library); err != nil {
+		return nil, err
+	}
+
+	fields := ResourceNetboxDcimDeviceType().Schema
+	values := map[string]interface{}{}
+	for key, value := range deviceTypeLibraryFields {
+		if library[key] == nil && value == nil {
+			return nil, fmt.Errorf("%s is required", key)
+		}
+		v, err := convertLibraryValue(library[key], fields[key])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		if library[key] == nil {
+			v = value
+		}
+		values[key] = v
+	}
+
+	for _, kind := range deviceTypeTemplateKinds {
+		values[kind.key] = []interface{}{}
+		if library[kind.yamlKey] == nil {
+			continue
+		}
+		templates, err := kind.fromLibrary(library[kind.yamlKey])
+		if err != nil {
+			return nil, err
+		}
+		values[kind.key] = templates
+	}
+
+	return values, nil
+}
+
+func resourceNetboxDcimDeviceTypeCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	model := d.Get("model").(string)
+	slug := d.Get("slug").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+	uHeight := d.Get("u_height").(float64)
+
+	newResource := &models.WritableDeviceType{
+		Airflow:       d.Get("airflow").(string),
+		Comments:      d.Get("comments").(string),
+		CustomFields:  customFields,
+		IsFullDepth:   d.Get("is_full_depth").(bool),
+		Manufacturer:  &manufacturerID,
+		Model:         &model,
+		PartNumber:    d.Get("part_number").(string),
+		Slug:          &slug,
+		SubdeviceRole: d.Get("subdevice_role").(string),
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		UHeight:       &uHeight,
+	}
+
+	dropFields := []string{}
+	emptyFields := make(map[string]interface{})
+	if !newResource.IsFullDepth {
+		emptyFields["is_full_depth"] = false
+	}
+
+	resource := dcim.NewDcimDeviceTypesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimDeviceTypesCreate(resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, dropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	parent := componentTemplateParent{deviceTypeID: resourceCreated.Payload.ID}
+	if err := reconcileComponentTemplates(client, parent, deviceTypeTemplateKinds, d.Get); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimDeviceTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceTypeRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimDeviceTypesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimDeviceTypesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	var airflow string
+	if resource.Airflow != nil {
+		airflow = stringValue(resource.Airflow.Value)
+	}
+	if err = d.Set("airflow", airflow); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comments", resource.Comments); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("device_count", resource.DeviceCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_full_depth", resource.IsFullDepth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("manufacturer_id", util.GetNestedManufacturerID(resource.Manufacturer)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("model", resource.Model); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("part_number", resource.PartNumber); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("slug", resource.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var subdeviceRole string
+	if resource.SubdeviceRole != nil {
+		subdeviceRole = stringValue(resource.SubdeviceRole.Value)
+	}
+	if err = d.Set("subdevice_role", subdeviceRole); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("u_height", resource.UHeight); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	parent := componentTemplateParent{deviceTypeID: resource.ID}
+	templates, err := readComponentTemplates(client, parent, deviceTypeTemplateKinds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for key, value := range templates {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableDeviceType{}
+
+	if d.HasChange("airflow") {
+		airflow := d.Get("airflow").(string)
+		params.Airflow = airflow
+		modifiedFields["airflow"] = airflow
+	}
+	if d.HasChange("comments") {
+		comments := d.Get("comments").(string)
+		params.Comments = comments
+		modifiedFields["comments"] = comments
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("is_full_depth") {
+		isFullDepth := d.Get("is_full_depth").(bool)
+		params.IsFullDepth = isFullDepth
+		modifiedFields["is_full_depth"] = isFullDepth
+	}
+	if d.HasChange("manufacturer_id") {
+		manufacturerID := int64(d.Get("manufacturer_id").(int))
+		params.Manufacturer = &manufacturerID
+	}
+	if d.HasChange("model") {
+		model := d.Get("model").(string)
+		params.Model = &model
+	}
+	if d.HasChange("part_number") {
+		partNumber := d.Get("part_number").(string)
+		params.PartNumber = partNumber
+		modifiedFields["part_number"] = partNumber
+	}
+	if d.HasChange("slug") {
+		slug := d.Get("slug").(string)
+		params.Slug = &slug
+	}
+	if d.HasChange("subdevice_role") {
+		subdeviceRole := d.Get("subdevice_role").(string)
+		params.SubdeviceRole = subdeviceRole
+		modifiedFields["subdevice_role"] = subdeviceRole
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("u_height") {
+		uHeight := d.Get("u_height").(float64)
+		params.UHeight = &uHeight
+	}
+
+	resource := dcim.NewDcimDeviceTypesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimDeviceTypesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, deviceTypeRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateKeys := []string{}
+	for _, kind := range deviceTypeTemplateKinds {
+		templateKeys = append(templateKeys, kind.key)
+	}
+	if d.HasChanges(templateKeys...) {
+		parent := componentTemplateParent{deviceTypeID: resourceID}
+		if err := reconcileComponentTemplates(client, parent, deviceTypeTemplateKinds, d.Get); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxDcimDeviceTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimDeviceTypeDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimDeviceTypeExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimDeviceTypesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimDeviceTypesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimDeviceTypesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,255 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimDeviceType = "netbox_dcim_device_type.test"
+
+func TestAccNetboxDcimDeviceTypeMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDeviceType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimDeviceType,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfigWithRearPort(nameSuffix, true, true, "Rear2"),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimDeviceType, "rear_port_template.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameNetboxDcimDeviceType, "front_port_template.*", map[string]string{
+						"name":      "Front1",
+						"rear_port": "Rear2",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimDeviceTypeLibraryYAML(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimDeviceTypeLibraryYAMLConfig(nameSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimDeviceType),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimDeviceType, "model", "Test "+nameSuffix),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimDeviceType, "interface_template.#", "2"),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimDeviceType, "front_port_template.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimDeviceTypeConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	return testAccCheckNetboxDcimDeviceTypeConfigWithRearPort(nameSuffix, resourceFull, extraResources, "Rear1")
+}
+
+// testAccCheckNetboxDcimDeviceTypeConfigWithRearPort names rearPort the rear
+// port template referenced by the front port template
+func testAccCheckNetboxDcimDeviceTypeConfigWithRearPort(nameSuffix string, resourceFull, extraResources bool,
+	rearPort string) string {
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+		{{ if eq .resourcefull "true" }}
+		airflow        = "front-to-rear"
+		comments       = "Test device type"
+		is_full_depth  = false
+		part_number    = "TEST-{{ .namesuffix }}"
+		subdevice_role = "parent"
+		u_height       = 2
+
+		console_port_template {
+			name = "Console"
+			type = "rj-45"
+		}
+
+		power_port_template {
+			name         = "PSU1"
+			type         = "iec-60320-c14"
+			maximum_draw = 500
+		}
+
+		power_outlet_template {
+			name       = "Outlet1"
+			type       = "iec-60320-c13"
+			power_port = "PSU1"
+			feed_leg   = "A"
+		}
+
+		interface_template {
+			name      = "eth0"
+			type      = "1000base-t"
+			mgmt_only = true
+		}
+
+		rear_port_template {
+			name      = "{{ .rearport }}"
+			type      = "8p8c"
+			positions = 2
+		}
+
+		front_port_template {
+			name               = "Front1"
+			type               = "8p8c"
+			rear_port          = "{{ .rearport }}"
+			rear_port_position = 2
+		}
+
+		device_bay_template {
+			name  = "Bay1"
+			label = "Bay 1"
+		}
+
+		module_bay_template {
+			name     = "Slot1"
+			position = "1"
+		}
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+		"rearport":       rearPort,
+	}
+	return util.RenderTemplate(template, data)
+}
+
+func testAccCheckNetboxDcimDeviceTypeLibraryYAMLConfig(nameSuffix string) string {
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		library_yaml    = <<-EOT
+		manufacturer: Test
+		model: Test {{ .namesuffix }}
+		slug: test-{{ .namesuffix }}
+		u_height: 1
+		is_full_depth: false
+		interfaces:
+		  - name: eth0
+		    type: 1000base-t
+		    mgmt_only: true
+		  - name: eth1
+		    type: 10gbase-x-sfpp
+		rear-ports:
+		  - name: Rear1
+		    type: lc
+		front-ports:
+		  - name: Front1
+		    type: lc
+		    rear_port: Rear1
+		EOT
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,410 @@
+package dcim
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimFrontPort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a front port of a device (dcim module) within Netbox. A front port created on the device from the templates of its device type or of the module type of one of its modules is adopted, its fields are overwritten. Any other existing front port with the same name is not adopted.",
+		CreateContext: resourceNetboxDcimFrontPortCreate,
+		ReadContext:   resourceNetboxDcimFrontPortRead,
+		UpdateContext: resourceNetboxDcimFrontPortUpdate,
+		DeleteContext: resourceNetboxDcimFrontPortDelete,
+		Exists:        resourceNetboxDcimFrontPortExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/dcim/front-ports/", "type"),
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("front port", listFrontPortIDs),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[0-9a-f]{6}$"),
+					"^[0-9a-f]{6}$"),
+				Description: "The color of this front port (dcim module), as a 6 digits hexadecimal code.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this front port (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this front port was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this front port (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this front port (dcim module).",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this front port (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this front port was last updated.",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this front port (dcim module) is considered connected without a cable.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this front port (dcim module).",
+			},
+			"rear_port_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The rear port of the same device this front port (dcim module) is mapped to.",
+			},
+			"rear_port_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1024),
+				Description:  "The position of the rear port this front port (dcim module) is mapped to (1 by default).",
+			},
+			"tag": &tag.TagSchema,
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of this front port (dcim module), e.g. 8p8c or lc.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this front port (dcim module).",
+			},
+		},
+	}
+}
+
+var frontPortRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"name",
+	"rear_port",
+	"tags",
+	"type",
+}
+
+// listFrontPortIDs returns the IDs of the front ports named name of the
+// device whose ID is deviceID or whose name is device
+func listFrontPortIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimFrontPortsListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimFrontPortsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimFrontPortCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	color := d.Get("color").(string)
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	label := d.Get("label").(string)
+	markConnected := d.Get("mark_connected").(bool)
+	name := d.Get("name").(string)
+	rearPortID := int64(d.Get("rear_port_id").(int))
+	rearPortPosition := int64(d.Get("rear_port_position").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	frontPortType := d.Get("type").(string)
+
+	newResource := &models.WritableFrontPort{
+		Color:            color,
+		CustomFields:     customFields,
+		Description:      description,
+		Device:           &deviceID,
+		Label:            label,
+		MarkConnected:    markConnected,
+		Name:             &name,
+		RearPort:         &rearPortID,
+		RearPortPosition: rearPortPosition,
+		Tags:             tag.ConvertTagsToNestedTags(tags),
+		Type:             &frontPortType,
+	}
+
+	// The front ports created from the templates of the device type or of its
+	// modules are adopted, all their fields are overwritten
+	resourceID, err := templatedComponentID(client, frontPortTemplateKind, listFrontPortIDs, deviceID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resourceID != 0 {
+		modifiedFields := map[string]interface{}{
+			"color":          color,
+			"description":    description,
+			"label":          label,
+			"mark_connected": markConnected,
+		}
+
+		resource := dcim.NewDcimFrontPortsPartialUpdateParams().WithID(resourceID).WithData(newResource)
+		if _, err := client.Dcim.DcimFrontPortsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, frontPortRequiredFields)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.FormatInt(resourceID, 10))
+
+		return resourceNetboxDcimFrontPortRead(ctx, d, m)
+	}
+
+	resource := dcim.NewDcimFrontPortsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimFrontPortsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimFrontPortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimFrontPortRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimFrontPortsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimFrontPortsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("color", resource.Color); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mark_connected", resource.MarkConnected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var rearPortID *int64
+	if resource.RearPort != nil {
+		rearPortID = &resource.RearPort.ID
+	}
+	if err = d.Set("rear_port_id", rearPortID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("rear_port_position", resource.RearPortPosition); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var frontPortType string
+	if resource.Type != nil {
+		frontPortType = stringValue(resource.Type.Value)
+	}
+	if err = d.Set("type", frontPortType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableFrontPort{}
+
+	if d.HasChange("color") {
+		color := d.Get("color").(string)
+		params.Color = color
+		modifiedFields["color"] = color
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("mark_connected") {
+		markConnected := d.Get("mark_connected").(bool)
+		params.MarkConnected = markConnected
+		modifiedFields["mark_connected"] = markConnected
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("rear_port_id") {
+		rearPortID := int64(d.Get("rear_port_id").(int))
+		params.RearPort = &rearPortID
+	}
+	if d.HasChange("rear_port_position") {
+		params.RearPortPosition = int64(d.Get("rear_port_position").(int))
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("type") {
+		frontPortType := d.Get("type").(string)
+		params.Type = &frontPortType
+	}
+
+	resource := dcim.NewDcimFrontPortsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimFrontPortsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, frontPortRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimFrontPortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimFrontPortDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimFrontPortExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimFrontPortsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimFrontPortsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimFrontPortsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimFrontPortsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
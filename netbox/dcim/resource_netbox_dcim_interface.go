@@ -0,0 +1,806 @@
+package dcim
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimInterface() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an interface of a device (dcim module) within Netbox. An interface created on the device from the templates of its device type or of the module type of one of its modules is adopted, its fields are overwritten. Any other existing interface with the same name is not adopted.",
+		CreateContext: resourceNetboxDcimInterfaceCreate,
+		ReadContext:   resourceNetboxDcimInterfaceRead,
+		UpdateContext: resourceNetboxDcimInterfaceUpdate,
+		DeleteContext: resourceNetboxDcimInterfaceDelete,
+		Exists:        resourceNetboxDcimInterfaceExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/dcim/interfaces/", "poe_type", "rf_channel", "type"),
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("interface", listInterfaceIDs),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bridge_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The bridge interface this interface (dcim module) belongs to.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this interface (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this interface was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this interface (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this interface (dcim module).",
+			},
+			"duplex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"half", "full", "auto"}, false),
+				Description:  "The duplex of this interface (dcim module) among half, full or auto.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether this interface (dcim module) is enabled (true by default).",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this interface (dcim module).",
+			},
+			"lag_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The LAG interface this interface (dcim module) is a member of.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this interface was last updated.",
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^([A-Z0-9]{2}:){5}[A-Z0-9]{2}$"),
+					"Must be like AA:AA:AA:AA:AA"),
+				Description: "The MAC address of this interface (dcim module).",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this interface (dcim module) is considered connected without a cable.",
+			},
+			"mgmt_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this interface (dcim module) is only used for out-of-band management.",
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"access", "tagged",
+					"tagged-all"}, false),
+				Description: "The 802.1Q mode among access, tagged or tagged-all.",
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65536),
+				Description:  "The MTU between 1 and 65536 of this interface (dcim module).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this interface (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The parent interface of this interface (dcim module).",
+			},
+			"poe_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pd", "pse"}, false),
+				Description:  "The PoE mode of this interface (dcim module) among pd or pse.",
+			},
+			"poe_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PoE type of this interface (dcim module) (e.g. type1-ieee802.3af).",
+			},
+			"rf_channel": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The wireless channel of this interface (dcim module) (e.g. 2.4g-1-2412-22).",
+			},
+			"rf_channel_frequency": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "The channel frequency in MHz of this interface (dcim module), computed from rf_channel when it is set.",
+			},
+			"rf_channel_width": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "The channel width in MHz of this interface (dcim module), computed from rf_channel when it is set.",
+			},
+			"rf_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ap", "station"}, false),
+				Description:  "The wireless role of this interface (dcim module) among ap or station.",
+			},
+			"speed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The speed in Kbps of this interface (dcim module).",
+			},
+			"tag": &tag.TagSchema,
+			"tagged_vlans": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional:    true,
+				Description: "The IDs of the VLANs tagged on this interface (dcim module) in tagged mode.",
+			},
+			"tx_power": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 127),
+				Description:  "The transmit power in dBm of this interface (dcim module).",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of this interface (dcim module) (e.g. 1000base-t, lag or virtual).",
+			},
+			"untagged_vlan": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the VLAN untagged on this interface (dcim module).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this interface (dcim module).",
+			},
+			"vrf_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The VRF of this interface (dcim module).",
+			},
+			"wireless_lans": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional:    true,
+				Description: "The IDs of the wireless LANs of this interface (dcim module).",
+			},
+			"wwn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 23),
+				Description:  "The World Wide Name of this interface (dcim module), e.g. 50:01:43:80:12:34:56:78.",
+			},
+		},
+	}
+}
+
+var interfaceRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"name",
+	"tagged_vlans",
+	"tags",
+	"type",
+	"wireless_lans",
+}
+
+// listInterfaceIDs returns the IDs of the interfaces named name of the
+// device whose ID is deviceID or whose name is device
+func listInterfaceIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimInterfacesListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimInterfaceCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	bridgeID := int64(d.Get("bridge_id").(int))
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	duplex := d.Get("duplex").(string)
+	enabled := d.Get("enabled").(bool)
+	label := d.Get("label").(string)
+	lagID := int64(d.Get("lag_id").(int))
+	macAddress := d.Get("mac_address").(string)
+	markConnected := d.Get("mark_connected").(bool)
+	mgmtOnly := d.Get("mgmt_only").(bool)
+	mode := d.Get("mode").(string)
+	mtu := int64(d.Get("mtu").(int))
+	name := d.Get("name").(string)
+	parentID := int64(d.Get("parent_id").(int))
+	poeMode := d.Get("poe_mode").(string)
+	poeType := d.Get("poe_type").(string)
+	rfChannel := d.Get("rf_channel").(string)
+	rfChannelFrequency := d.Get("rf_channel_frequency").(float64)
+	rfChannelWidth := d.Get("rf_channel_width").(float64)
+	rfRole := d.Get("rf_role").(string)
+	speed := int64(d.Get("speed").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	taggedVlans := d.Get("tagged_vlans").(*schema.Set).List()
+	txPower := int64(d.Get("tx_power").(int))
+	interfaceType := d.Get("type").(string)
+	untaggedVlan := int64(d.Get("untagged_vlan").(int))
+	vrfID := int64(d.Get("vrf_id").(int))
+	wirelessLans := d.Get("wireless_lans").(*schema.Set).List()
+	wwn := d.Get("wwn").(string)
+
+	newResource := &models.WritableInterface{
+		CustomFields:  customFields,
+		Description:   description,
+		Device:        &deviceID,
+		Enabled:       enabled,
+		Label:         label,
+		MarkConnected: markConnected,
+		MgmtOnly:      mgmtOnly,
+		Mode:          mode,
+		Name:          &name,
+		PoeMode:       poeMode,
+		PoeType:       poeType,
+		RfChannel:     rfChannel,
+		RfRole:        rfRole,
+		TaggedVlans:   util.ToListofInts(taggedVlans),
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		Type:          &interfaceType,
+		WirelessLans:  util.ToListofInts(wirelessLans),
+	}
+	if bridgeID != 0 {
+		newResource.Bridge = &bridgeID
+	}
+	if duplex != "" {
+		newResource.Duplex = &duplex
+	}
+	if lagID != 0 {
+		newResource.Lag = &lagID
+	}
+	if macAddress != "" {
+		newResource.MacAddress = &macAddress
+	}
+	if mtu != 0 {
+		newResource.Mtu = &mtu
+	}
+	if parentID != 0 {
+		newResource.Parent = &parentID
+	}
+	if rfChannelFrequency != 0 {
+		newResource.RfChannelFrequency = &rfChannelFrequency
+	}
+	if rfChannelWidth != 0 {
+		newResource.RfChannelWidth = &rfChannelWidth
+	}
+	if speed != 0 {
+		newResource.Speed = &speed
+	}
+	if txPower != 0 {
+		newResource.TxPower = &txPower
+	}
+	if untaggedVlan != 0 {
+		newResource.UntaggedVlan = &untaggedVlan
+	}
+	if vrfID != 0 {
+		newResource.Vrf = &vrfID
+	}
+	if wwn != "" {
+		newResource.Wwn = &wwn
+	}
+
+	// The interfaces created from the templates of the device type or of its
+	// modules are adopted, all their fields are overwritten
+	resourceID, err := templatedComponentID(client, interfaceTemplateKind, listInterfaceIDs, deviceID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resourceID != 0 {
+		modifiedFields := map[string]interface{}{
+			"bridge":               bridgeID,
+			"description":          description,
+			"duplex":               nil,
+			"enabled":              enabled,
+			"label":                label,
+			"lag":                  lagID,
+			"mac_address":          nil,
+			"mark_connected":       markConnected,
+			"mgmt_only":            mgmtOnly,
+			"mode":                 mode,
+			"mtu":                  mtu,
+			"parent":               parentID,
+			"poe_mode":             poeMode,
+			"poe_type":             poeType,
+			"rf_channel":           rfChannel,
+			"rf_channel_frequency": rfChannelFrequency,
+			"rf_channel_width":     rfChannelWidth,
+			"rf_role":              rfRole,
+			"speed":                speed,
+			"tx_power":             txPower,
+			"untagged_vlan":        untaggedVlan,
+			"vrf":                  vrfID,
+			"wwn":                  nil,
+		}
+		for key, value := range map[string]string{"duplex": duplex, "mac_address": macAddress, "wwn": wwn} {
+			if value != "" {
+				delete(modifiedFields, key)
+			}
+		}
+
+		resource := dcim.NewDcimInterfacesPartialUpdateParams().WithID(resourceID).WithData(newResource)
+		if _, err := client.Dcim.DcimInterfacesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, interfaceRequiredFields)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.FormatInt(resourceID, 10))
+
+		return resourceNetboxDcimInterfaceRead(ctx, d, m)
+	}
+
+	resource := dcim.NewDcimInterfacesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimInterfacesCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimInterfaceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInterfaceRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimInterfacesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("bridge_id", util.GetNestedInterfaceID(resource.Bridge)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var duplex string
+	if resource.Duplex != nil {
+		duplex = stringValue(resource.Duplex.Value)
+	}
+	if err = d.Set("duplex", duplex); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("enabled", resource.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("lag_id", util.GetNestedInterfaceID(resource.Lag)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mac_address", resource.MacAddress); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mark_connected", resource.MarkConnected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mgmt_only", resource.MgmtOnly); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var mode string
+	if resource.Mode != nil {
+		mode = stringValue(resource.Mode.Value)
+	}
+	if err = d.Set("mode", mode); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("mtu", resource.Mtu); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", util.GetNestedInterfaceID(resource.Parent)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var poeMode, poeType string
+	if resource.PoeMode != nil {
+		poeMode = stringValue(resource.PoeMode.Value)
+	}
+	if resource.PoeType != nil {
+		poeType = stringValue(resource.PoeType.Value)
+	}
+	if err = d.Set("poe_mode", poeMode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("poe_type", poeType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var rfChannel, rfRole string
+	if resource.RfChannel != nil {
+		rfChannel = stringValue(resource.RfChannel.Value)
+	}
+	if resource.RfRole != nil {
+		rfRole = stringValue(resource.RfRole.Value)
+	}
+	if err = d.Set("rf_channel", rfChannel); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rf_channel_frequency", resource.RfChannelFrequency); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rf_channel_width", resource.RfChannelWidth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rf_role", rfRole); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("speed", resource.Speed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	taggedVlans := []int64{}
+	for _, vlan := range resource.TaggedVlans {
+		taggedVlans = append(taggedVlans, vlan.ID)
+	}
+	if err = d.Set("tagged_vlans", taggedVlans); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("tx_power", resource.TxPower); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var interfaceType string
+	if resource.Type != nil {
+		interfaceType = stringValue(resource.Type.Value)
+	}
+	if err = d.Set("type", interfaceType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("untagged_vlan", util.GetNestedVLANID(resource.UntaggedVlan)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("vrf_id", util.GetNestedVRFID(resource.Vrf)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	wirelessLans := []int64{}
+	for _, wirelessLan := range resource.WirelessLans {
+		wirelessLans = append(wirelessLans, wirelessLan.ID)
+	}
+	if err = d.Set("wireless_lans", wirelessLans); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("wwn", resource.Wwn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableInterface{}
+
+	if d.HasChange("bridge_id") {
+		bridgeID := int64(d.Get("bridge_id").(int))
+		params.Bridge = &bridgeID
+		modifiedFields["bridge"] = bridgeID
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("duplex") {
+		duplex := d.Get("duplex").(string)
+		if duplex != "" {
+			params.Duplex = &duplex
+		} else {
+			modifiedFields["duplex"] = nil
+		}
+	}
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		params.Enabled = enabled
+		modifiedFields["enabled"] = enabled
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("lag_id") {
+		lagID := int64(d.Get("lag_id").(int))
+		params.Lag = &lagID
+		modifiedFields["lag"] = lagID
+	}
+	if d.HasChange("mac_address") {
+		macAddress := d.Get("mac_address").(string)
+		if macAddress != "" {
+			params.MacAddress = &macAddress
+		} else {
+			modifiedFields["mac_address"] = nil
+		}
+	}
+	if d.HasChange("mark_connected") {
+		markConnected := d.Get("mark_connected").(bool)
+		params.MarkConnected = markConnected
+		modifiedFields["mark_connected"] = markConnected
+	}
+	if d.HasChange("mgmt_only") {
+		mgmtOnly := d.Get("mgmt_only").(bool)
+		params.MgmtOnly = mgmtOnly
+		modifiedFields["mgmt_only"] = mgmtOnly
+	}
+	if d.HasChange("mode") {
+		mode := d.Get("mode").(string)
+		params.Mode = mode
+		modifiedFields["mode"] = mode
+	}
+	if d.HasChange("mtu") {
+		mtu := int64(d.Get("mtu").(int))
+		params.Mtu = &mtu
+		modifiedFields["mtu"] = mtu
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("parent_id") {
+		parentID := int64(d.Get("parent_id").(int))
+		params.Parent = &parentID
+		modifiedFields["parent"] = parentID
+	}
+	if d.HasChange("poe_mode") {
+		poeMode := d.Get("poe_mode").(string)
+		params.PoeMode = poeMode
+		modifiedFields["poe_mode"] = poeMode
+	}
+	if d.HasChange("poe_type") {
+		poeType := d.Get("poe_type").(string)
+		params.PoeType = poeType
+		modifiedFields["poe_type"] = poeType
+	}
+	if d.HasChange("rf_channel") {
+		rfChannel := d.Get("rf_channel").(string)
+		params.RfChannel = rfChannel
+		modifiedFields["rf_channel"] = rfChannel
+	}
+	if d.HasChange("rf_channel_frequency") {
+		rfChannelFrequency := d.Get("rf_channel_frequency").(float64)
+		params.RfChannelFrequency = &rfChannelFrequency
+		modifiedFields["rf_channel_frequency"] = rfChannelFrequency
+	}
+	if d.HasChange("rf_channel_width") {
+		rfChannelWidth := d.Get("rf_channel_width").(float64)
+		params.RfChannelWidth = &rfChannelWidth
+		modifiedFields["rf_channel_width"] = rfChannelWidth
+	}
+	if d.HasChange("rf_role") {
+		rfRole := d.Get("rf_role").(string)
+		params.RfRole = rfRole
+		modifiedFields["rf_role"] = rfRole
+	}
+	if d.HasChange("speed") {
+		speed := int64(d.Get("speed").(int))
+		params.Speed = &speed
+		modifiedFields["speed"] = speed
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tagged_vlans") {
+		taggedVlans := d.Get("tagged_vlans").(*schema.Set).List()
+		params.TaggedVlans = util.ToListofInts(taggedVlans)
+	}
+	if d.HasChange("tx_power") {
+		txPower := int64(d.Get("tx_power").(int))
+		params.TxPower = &txPower
+		modifiedFields["tx_power"] = txPower
+	}
+	if d.HasChange("type") {
+		interfaceType := d.Get("type").(string)
+		params.Type = &interfaceType
+	}
+	if d.HasChange("untagged_vlan") {
+		untaggedVlan := int64(d.Get("untagged_vlan").(int))
+		params.UntaggedVlan = &untaggedVlan
+		modifiedFields["untagged_vlan"] = untaggedVlan
+	}
+	if d.HasChange("vrf_id") {
+		vrfID := int64(d.Get("vrf_id").(int))
+		params.Vrf = &vrfID
+		modifiedFields["vrf"] = vrfID
+	}
+	if d.HasChange("wireless_lans") {
+		wirelessLans := d.Get("wireless_lans").(*schema.Set).List()
+		params.WirelessLans = util.ToListofInts(wirelessLans)
+	}
+	if d.HasChange("wwn") {
+		wwn := d.Get("wwn").(string)
+		if wwn != "" {
+			params.Wwn = &wwn
+		} else {
+			modifiedFields["wwn"] = nil
+		}
+	}
+
+	resource := dcim.NewDcimInterfacesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimInterfacesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, interfaceRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimInterfaceRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInterfaceDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimInterfaceExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimInterfacesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimInterfacesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimInterfacesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimInterfacesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,190 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimInterface = "netbox_dcim_interface.test"
+
+func TestAccNetboxDcimInterfaceMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInterface,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInterfaceFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInterface,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInterface,
+				ImportState:       true,
+				ImportStateId:     "test-" + nameSuffix + "/eth0",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInterfaceMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInterfaceConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInterface),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimInterfaceConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+
+		interface_template {
+			name = "eth0"
+			type = "1000base-t"
+		}
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_dcim_interface" "lag" {
+		device_id = netbox_dcim_device.test.id
+		name      = "bond0"
+		type      = "lag"
+	}
+
+	resource "netbox_ipam_vlan" "test1" {
+		name    = "test-{{ .namesuffix }}-1"
+		vlan_id = 101
+	}
+
+	resource "netbox_ipam_vlan" "test2" {
+		name    = "test-{{ .namesuffix }}-2"
+		vlan_id = 102
+	}
+
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth0"
+		type      = "1000base-t"
+		{{ if eq .resourcefull "true" }}
+		description   = "Test interface"
+		duplex        = "full"
+		enabled       = false
+		label         = "Port 0"
+		lag_id        = netbox_dcim_interface.lag.id
+		mac_address   = "AA:BB:CC:DD:EE:FF"
+		mgmt_only     = true
+		mode          = "tagged"
+		mtu           = 9000
+		poe_mode      = "pse"
+		poe_type      = "type2-ieee802.3at"
+		speed         = 1000000
+		tagged_vlans  = [netbox_ipam_vlan.test2.id]
+		untagged_vlan = netbox_ipam_vlan.test1.id
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
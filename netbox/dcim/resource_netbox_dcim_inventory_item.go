@@ -0,0 +1,470 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+// Types of the components to which an inventory item can be assigned
+var inventoryItemComponentTypes = []string{
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+func ResourceNetboxDcimInventoryItem() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an inventory item of a device (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimInventoryItemCreate,
+		ReadContext:   resourceNetboxDcimInventoryItemRead,
+		UpdateContext: resourceNetboxDcimInventoryItemUpdate,
+		DeleteContext: resourceNetboxDcimInventoryItemDelete,
+		Exists:        resourceNetboxDcimInventoryItemExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("inventory item", listInventoryItemIDs),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(childrenDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The asset tag of this inventory item (dcim module), unique in Netbox.",
+			},
+			"component_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"component_type"},
+				Description:  "The ID of the component of the device to which this inventory item (dcim module) is assigned.",
+			},
+			"component_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"component_id"},
+				ValidateFunc: validation.StringInSlice(inventoryItemComponentTypes, false),
+				Description:  "The type of the component of the device to which this inventory item (dcim module) is assigned, e.g. dcim.interface.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this inventory item (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this inventory item was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this inventory item (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this inventory item (dcim module).",
+			},
+			"discovered": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this inventory item (dcim module) was discovered automatically.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this inventory item (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this inventory item was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The manufacturer of this inventory item (dcim module).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this inventory item (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent of this inventory item (dcim module), it must belong to the same device.",
+			},
+			"part_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The part ID assigned by the manufacturer to this inventory item (dcim module).",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The role of this inventory item (dcim module).",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The serial number of this inventory item (dcim module).",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this inventory item (dcim module).",
+			},
+		},
+	}
+}
+
+var inventoryItemRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"name",
+	"tags",
+}
+
+// listInventoryItemIDs returns the IDs of the inventory items named name of
+// the device whose ID is deviceID or whose name is device
+func listInventoryItemIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimInventoryItemsListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimInventoryItemsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimInventoryItemCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	assetTag := d.Get("asset_tag").(string)
+	componentID := int64(d.Get("component_id").(int))
+	componentType := d.Get("component_type").(string)
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	discovered := d.Get("discovered").(bool)
+	label := d.Get("label").(string)
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	name := d.Get("name").(string)
+	parentID := int64(d.Get("parent_id").(int))
+	partID := d.Get("part_id").(string)
+	roleID := int64(d.Get("role_id").(int))
+	serial := d.Get("serial").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.WritableInventoryItem{
+		CustomFields: customFields,
+		Description:  description,
+		Device:       &deviceID,
+		Discovered:   discovered,
+		Label:        label,
+		Name:         &name,
+		PartID:       partID,
+		Serial:       serial,
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+	if assetTag != "" {
+		newResource.AssetTag = &assetTag
+	}
+	if componentType != "" {
+		newResource.ComponentID = &componentID
+		newResource.ComponentType = &componentType
+	}
+	if manufacturerID != 0 {
+		newResource.Manufacturer = &manufacturerID
+	}
+	if parentID != 0 {
+		newResource.Parent = &parentID
+	}
+	if roleID != 0 {
+		newResource.Role = &roleID
+	}
+
+	resource := dcim.NewDcimInventoryItemsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimInventoryItemsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimInventoryItemRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInventoryItemRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimInventoryItemsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimInventoryItemsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("asset_tag", resource.AssetTag); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("component_id", resource.ComponentID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("component_type", resource.ComponentType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("discovered", resource.Discovered); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("manufacturer_id", util.GetNestedManufacturerID(resource.Manufacturer)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", resource.Parent); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("part_id", resource.PartID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role_id", util.GetNestedInventoryItemRoleID(resource.Role)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("serial", resource.Serial); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableInventoryItem{}
+
+	if d.HasChange("asset_tag") {
+		assetTag := d.Get("asset_tag").(string)
+		if assetTag != "" {
+			params.AssetTag = &assetTag
+		} else {
+			modifiedFields["asset_tag"] = nil
+		}
+	}
+	if d.HasChanges("component_id", "component_type") {
+		componentID := int64(d.Get("component_id").(int))
+		componentType := d.Get("component_type").(string)
+		if componentType != "" {
+			params.ComponentID = &componentID
+			params.ComponentType = &componentType
+		} else {
+			modifiedFields["component_id"] = nil
+			modifiedFields["component_type"] = nil
+		}
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("discovered") {
+		discovered := d.Get("discovered").(bool)
+		params.Discovered = discovered
+		modifiedFields["discovered"] = discovered
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("manufacturer_id") {
+		manufacturerID := int64(d.Get("manufacturer_id").(int))
+		params.Manufacturer = &manufacturerID
+		modifiedFields["manufacturer"] = manufacturerID
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("parent_id") {
+		parentID := int64(d.Get("parent_id").(int))
+		params.Parent = &parentID
+		modifiedFields["parent"] = parentID
+	}
+	if d.HasChange("part_id") {
+		partID := d.Get("part_id").(string)
+		params.PartID = partID
+		modifiedFields["part_id"] = partID
+	}
+	if d.HasChange("role_id") {
+		roleID := int64(d.Get("role_id").(int))
+		params.Role = &roleID
+		modifiedFields["role"] = roleID
+	}
+	if d.HasChange("serial") {
+		serial := d.Get("serial").(string)
+		params.Serial = serial
+		modifiedFields["serial"] = serial
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := dcim.NewDcimInventoryItemsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimInventoryItemsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, inventoryItemRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimInventoryItemRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInventoryItemDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimInventoryItemExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	err = waitForNoChildren(ctx, d.Timeout(schema.TimeoutDelete), "Inventory item", id, func() (int64, error) {
+		return countInventoryItemChildren(client, id)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource := dcim.NewDcimInventoryItemsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimInventoryItemsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimInventoryItemsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimInventoryItemsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
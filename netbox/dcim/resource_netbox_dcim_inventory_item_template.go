@@ -0,0 +1,364 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+// Types of the component templates to which an inventory item template can
+// be assigned
+var inventoryItemTemplateComponentTypes = []string{
+	"dcim.consoleporttemplate",
+	"dcim.consoleserverporttemplate",
+	"dcim.frontporttemplate",
+	"dcim.interfacetemplate",
+	"dcim.poweroutlettemplate",
+	"dcim.powerporttemplate",
+	"dcim.rearporttemplate",
+}
+
+func ResourceNetboxDcimInventoryItemTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage an inventory item template of a device type (dcim module) within Netbox. An inventory item is created from it on every new device of the device type.",
+		CreateContext: resourceNetboxDcimInventoryItemTemplateCreate,
+		ReadContext:   resourceNetboxDcimInventoryItemTemplateRead,
+		UpdateContext: resourceNetboxDcimInventoryItemTemplateUpdate,
+		DeleteContext: resourceNetboxDcimInventoryItemTemplateDelete,
+		Exists:        resourceNetboxDcimInventoryItemTemplateExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(childrenDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"component_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"component_type"},
+				Description:  "The ID of the component template of the device type to which this inventory item template (dcim module) is assigned.",
+			},
+			"component_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"component_id"},
+				ValidateFunc: validation.StringInSlice(inventoryItemTemplateComponentTypes, false),
+				Description:  "The type of the component template of the device type to which this inventory item template (dcim module) is assigned, e.g. dcim.interfacetemplate.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this inventory item template (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this inventory item template was created.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this inventory item template (dcim module).",
+			},
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device type of this inventory item template (dcim module).",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this inventory item template (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this inventory item template was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The manufacturer of this inventory item template (dcim module).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this inventory item template (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent of this inventory item template (dcim module), it must belong to the same device type.",
+			},
+			"part_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The part ID assigned by the manufacturer to this inventory item template (dcim module).",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The role of this inventory item template (dcim module).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this inventory item template (dcim module).",
+			},
+		},
+	}
+}
+
+var inventoryItemTemplateRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device_type",
+	"name",
+}
+
+func resourceNetboxDcimInventoryItemTemplateCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	componentID := int64(d.Get("component_id").(int))
+	componentType := d.Get("component_type").(string)
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	name := d.Get("name").(string)
+	parentID := int64(d.Get("parent_id").(int))
+	roleID := int64(d.Get("role_id").(int))
+
+	newResource := &models.WritableInventoryItemTemplate{
+		Description: d.Get("description").(string),
+		DeviceType:  &deviceTypeID,
+		Label:       d.Get("label").(string),
+		Name:        &name,
+		PartID:      d.Get("part_id").(string),
+	}
+	if componentType != "" {
+		newResource.ComponentID = &componentID
+		newResource.ComponentType = &componentType
+	}
+	if manufacturerID != 0 {
+		newResource.Manufacturer = &manufacturerID
+	}
+	if parentID != 0 {
+		newResource.Parent = &parentID
+	}
+	if roleID != 0 {
+		newResource.Role = &roleID
+	}
+
+	resource := dcim.NewDcimInventoryItemTemplatesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimInventoryItemTemplatesCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimInventoryItemTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInventoryItemTemplateRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimInventoryItemTemplatesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimInventoryItemTemplatesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("component_id", resource.ComponentID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("component_type", resource.ComponentType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("device_type_id", util.GetNestedDeviceTypeID(resource.DeviceType)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("manufacturer_id", util.GetNestedManufacturerID(resource.Manufacturer)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", resource.Parent); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("part_id", resource.PartID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role_id", util.GetNestedInventoryItemRoleID(resource.Role)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemTemplateUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableInventoryItemTemplate{}
+
+	if d.HasChanges("component_id", "component_type") {
+		componentID := int64(d.Get("component_id").(int))
+		componentType := d.Get("component_type").(string)
+		if componentType != "" {
+			params.ComponentID = &componentID
+			params.ComponentType = &componentType
+		} else {
+			modifiedFields["component_id"] = nil
+			modifiedFields["component_type"] = nil
+		}
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("manufacturer_id") {
+		manufacturerID := int64(d.Get("manufacturer_id").(int))
+		params.Manufacturer = &manufacturerID
+		modifiedFields["manufacturer"] = manufacturerID
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("parent_id") {
+		parentID := int64(d.Get("parent_id").(int))
+		params.Parent = &parentID
+		modifiedFields["parent"] = parentID
+	}
+	if d.HasChange("part_id") {
+		partID := d.Get("part_id").(string)
+		params.PartID = partID
+		modifiedFields["part_id"] = partID
+	}
+	if d.HasChange("role_id") {
+		roleID := int64(d.Get("role_id").(int))
+		params.Role = &roleID
+		modifiedFields["role"] = roleID
+	}
+
+	resource := dcim.NewDcimInventoryItemTemplatesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimInventoryItemTemplatesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, inventoryItemTemplateRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimInventoryItemTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxDcimInventoryItemTemplateDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimInventoryItemTemplateExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	err = waitForNoChildren(ctx, d.Timeout(schema.TimeoutDelete), "Inventory item template", id, func() (int64, error) {
+		return countInventoryItemTemplateChildren(client, id)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource := dcim.NewDcimInventoryItemTemplatesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimInventoryItemTemplatesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemTemplateExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimInventoryItemTemplatesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimInventoryItemTemplatesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
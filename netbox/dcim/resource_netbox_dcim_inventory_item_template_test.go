@@ -0,0 +1,137 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimInventoryItemTemplate = "netbox_dcim_inventory_item_template.test"
+
+func TestAccNetboxDcimInventoryItemTemplateMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItemTemplate),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInventoryItemTemplate,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInventoryItemTemplateFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItemTemplate),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInventoryItemTemplate,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInventoryItemTemplateMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItemTemplate),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItemTemplate),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItemTemplate),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItemTemplate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimInventoryItemTemplateConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_dcim_inventory_item_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_inventory_item_template" "parent" {
+		device_type_id = netbox_dcim_device_type.test.id
+		name           = "Power supplies"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_inventory_item_template" "test" {
+		device_type_id = netbox_dcim_device_type.test.id
+		name           = "PSU1"
+		{{ if eq .resourcefull "true" }}
+		description     = "Test inventory item template"
+		label           = "PSU 1"
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		parent_id       = netbox_dcim_inventory_item_template.parent.id
+		part_id         = "PWR-650W-AC"
+		role_id         = netbox_dcim_inventory_item_role.test.id
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
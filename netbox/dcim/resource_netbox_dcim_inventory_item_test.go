@@ -0,0 +1,181 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimInventoryItem = "netbox_dcim_inventory_item.test"
+
+func TestAccNetboxDcimInventoryItemMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInventoryItemConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItem),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInventoryItem,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInventoryItemFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInventoryItemConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItem),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInventoryItem,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimInventoryItem,
+				ImportState:       true,
+				ImportStateId:     "test-" + nameSuffix + "/Transceiver",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimInventoryItemMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimInventoryItemConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItem),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInventoryItemConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItem),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInventoryItemConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItem),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimInventoryItemConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimInventoryItem),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxDcimInventoryItemConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth0"
+		type      = "10gbase-x-sfpp"
+	}
+
+	resource "netbox_dcim_inventory_item_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_inventory_item" "parent" {
+		device_id = netbox_dcim_device.test.id
+		name      = "Linecard"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_inventory_item" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "Transceiver"
+		{{ if eq .resourcefull "true" }}
+		asset_tag       = "test-{{ .namesuffix }}"
+		component_id    = netbox_dcim_interface.test.id
+		component_type  = "dcim.interface"
+		description     = "Test inventory item"
+		discovered      = true
+		label           = "SFP+ 0"
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		parent_id       = netbox_dcim_inventory_item.parent.id
+		part_id         = "SFP-10G-SR"
+		role_id         = netbox_dcim_inventory_item_role.test.id
+		serial          = "ABC123"
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,354 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimLocation() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a location (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimLocationCreate,
+		ReadContext:   resourceNetboxDcimLocationRead,
+		UpdateContext: resourceNetboxDcimLocationUpdate,
+		DeleteContext: resourceNetboxDcimLocationDelete,
+		Exists:        resourceNetboxDcimLocationExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(childrenDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this location (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this location was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of this location (dcim module) in the tree of locations of its site, 0 for a root.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this location (dcim module).",
+			},
+			"device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices in this location (dcim module) and its children.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this location was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The name of this location (dcim module).",
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent of this location (dcim module), it must belong to the same site.",
+			},
+			"rack_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of racks in this location (dcim module) and its children.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the site of this location (dcim module).",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The slug of this location (dcim module).",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"planned", "staging", "active", "decommissioning", "retired"}, false),
+				Description:  "The status of this location (dcim module). Allowed values: \"active\" (default), \"planned\", \"staging\", \"decommissioning\", \"retired\".",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The tenant of this location (dcim module).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this location (dcim module).",
+			},
+		},
+	}
+}
+
+var locationRequiredFields = []string{
+	"created",
+	"last_updated",
+	"name",
+	"site",
+	"slug",
+	"tags",
+}
+
+func resourceNetboxDcimLocationCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	name := d.Get("name").(string)
+	parentID := int64(d.Get("parent_id").(int))
+	siteID := int64(d.Get("site_id").(int))
+	slug := d.Get("slug").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+	tenantID := int64(d.Get("tenant_id").(int))
+
+	newResource := &models.WritableLocation{
+		CustomFields: customFields,
+		Description:  d.Get("description").(string),
+		Name:         &name,
+		Site:         &siteID,
+		Slug:         &slug,
+		Status:       d.Get("status").(string),
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+	if parentID != 0 {
+		newResource.Parent = &parentID
+	}
+	if tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+
+	resource := dcim.NewDcimLocationsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimLocationsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimLocationRead(ctx, d, m)
+}
+
+func resourceNetboxDcimLocationRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimLocationsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimLocationsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("depth", resource.Depth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("device_count", resource.DeviceCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parent_id", util.GetNestedLocationID(resource.Parent)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rack_count", resource.RackCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("site_id", util.GetNestedSiteID(resource.Site)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("slug", resource.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", resource.Status.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimLocationUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableLocation{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("parent_id") {
+		parentID := int64(d.Get("parent_id").(int))
+		params.Parent = &parentID
+		modifiedFields["parent"] = parentID
+	}
+	if d.HasChange("site_id") {
+		siteID := int64(d.Get("site_id").(int))
+		params.Site = &siteID
+	}
+	if d.HasChange("slug") {
+		slug := d.Get("slug").(string)
+		params.Slug = &slug
+	}
+	if d.HasChange("status") {
+		params.Status = d.Get("status").(string)
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+
+	resource := dcim.NewDcimLocationsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimLocationsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, locationRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimLocationRead(ctx, d, m)
+}
+
+func resourceNetboxDcimLocationDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimLocationExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	err = waitForNoChildren(ctx, d.Timeout(schema.TimeoutDelete), "Location", id, func() (int64, error) {
+		return countLocationChildren(client, id)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource := dcim.NewDcimLocationsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimLocationsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimLocationExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimLocationsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimLocationsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,353 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimModule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a module installed in a module bay of a device (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimModuleCreate,
+		ReadContext:   resourceNetboxDcimModuleRead,
+		UpdateContext: resourceNetboxDcimModuleUpdate,
+		DeleteContext: resourceNetboxDcimModuleDelete,
+		Exists:        resourceNetboxDcimModuleExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"adopt_components": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the components of the device named like the templates of the module type are adopted when the module is installed (false by default). Changing it afterwards has no effect.",
+			},
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The asset tag of this module (dcim module), unique in Netbox.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments for this module (dcim module).",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this module (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this module (dcim module).",
+			},
+			"interfaces": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the interfaces of this module (dcim module) by name.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module was last updated.",
+			},
+			"module_bay_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The module bay of the device in which this module (dcim module) is installed.",
+			},
+			"module_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The module type of this module (dcim module).",
+			},
+			"replicate_components": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the components are created from the templates of the module type when the module is installed (true by default). Changing it afterwards has no effect.",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The serial number of this module (dcim module).",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this module (dcim module).",
+			},
+		},
+	}
+}
+
+var moduleRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"module_bay",
+	"module_type",
+	"tags",
+}
+
+func resourceNetboxDcimModuleCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	assetTag := d.Get("asset_tag").(string)
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	deviceID := int64(d.Get("device_id").(int))
+	moduleBayID := int64(d.Get("module_bay_id").(int))
+	moduleTypeID := int64(d.Get("module_type_id").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.WritableModule{
+		Comments:     d.Get("comments").(string),
+		CustomFields: customFields,
+		Device:       &deviceID,
+		ModuleBay:    &moduleBayID,
+		ModuleType:   &moduleTypeID,
+		Serial:       d.Get("serial").(string),
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+	if assetTag != "" {
+		newResource.AssetTag = &assetTag
+	}
+
+	// The options of the installation are not part of the model of the
+	// client
+	dropFields := []string{}
+	emptyFields := map[string]interface{}{
+		"adopt_components":     d.Get("adopt_components").(bool),
+		"replicate_components": d.Get("replicate_components").(bool),
+	}
+
+	resource := dcim.NewDcimModulesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimModulesCreate(resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, dropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimModuleRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimModulesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimModulesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("asset_tag", resource.AssetTag); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comments", resource.Comments); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	interfaces := map[string]interface{}{}
+	moduleIDFilter := strconv.FormatInt(resource.ID, 10)
+	interfacesParams := dcim.NewDcimInterfacesListParams().WithModuleID(&moduleIDFilter)
+	err = readAllPages(func(limit, offset *int64) (int64, int, error) {
+		list, err := client.Dcim.DcimInterfacesList(interfacesParams.WithLimit(limit).WithOffset(offset), nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, i := range list.Payload.Results {
+			interfaces[stringValue(i.Name)] = i.ID
+		}
+		return *list.Payload.Count, len(list.Payload.Results), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("interfaces", interfaces); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("module_bay_id", util.GetNestedModuleBayID(resource.ModuleBay)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("module_type_id", util.GetNestedModuleTypeID(resource.ModuleType)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("serial", resource.Serial); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableModule{}
+
+	if d.HasChange("asset_tag") {
+		assetTag := d.Get("asset_tag").(string)
+		if assetTag != "" {
+			params.AssetTag = &assetTag
+		} else {
+			modifiedFields["asset_tag"] = nil
+		}
+	}
+	if d.HasChange("comments") {
+		comments := d.Get("comments").(string)
+		params.Comments = comments
+		modifiedFields["comments"] = comments
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("module_type_id") {
+		moduleTypeID := int64(d.Get("module_type_id").(int))
+		params.ModuleType = &moduleTypeID
+	}
+	if d.HasChange("serial") {
+		serial := d.Get("serial").(string)
+		params.Serial = serial
+		modifiedFields["serial"] = serial
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := dcim.NewDcimModulesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimModulesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, moduleRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimModuleRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimModuleExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimModulesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimModulesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimModulesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimModulesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
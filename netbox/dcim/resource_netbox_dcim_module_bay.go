@@ -0,0 +1,350 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimModuleBay() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a module bay of a device (dcim module) within Netbox. A module bay created on the device from the templates of its device type is adopted, its fields are overwritten. Any other existing module bay with the same name is not adopted.",
+		CreateContext: resourceNetboxDcimModuleBayCreate,
+		ReadContext:   resourceNetboxDcimModuleBayRead,
+		UpdateContext: resourceNetboxDcimModuleBayUpdate,
+		DeleteContext: resourceNetboxDcimModuleBayDelete,
+		Exists:        resourceNetboxDcimModuleBayExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("module bay", listModuleBayIDs),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this module bay (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module bay was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this module bay (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this module bay (dcim module).",
+			},
+			"installed_module_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The module installed in this module bay (dcim module), 0 if there is none.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this module bay (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module bay was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this module bay (dcim module).",
+			},
+			"position": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 30),
+				Description:  "The position of this module bay (dcim module), which replaces the {module} placeholder in the names of the components of the installed module.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this module bay (dcim module).",
+			},
+		},
+	}
+}
+
+var moduleBayRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"installed_module",
+	"name",
+	"tags",
+}
+
+// listModuleBayIDs returns the IDs of the module bays named name of the
+// device whose ID is deviceID or whose name is device
+func listModuleBayIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimModuleBaysListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimModuleBaysList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimModuleBayCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	label := d.Get("label").(string)
+	name := d.Get("name").(string)
+	position := d.Get("position").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.WritableModuleBay{
+		CustomFields: customFields,
+		Description:  description,
+		Device:       &deviceID,
+		Label:        label,
+		Name:         &name,
+		Position:     position,
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+
+	// The module bays created from the templates of the device type are
+	// adopted, all their fields are overwritten
+	resourceID, err := templatedComponentID(client, moduleBayTemplateKind, listModuleBayIDs, deviceID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resourceID != 0 {
+		modifiedFields := map[string]interface{}{
+			"description": description,
+			"label":       label,
+			"position":    position,
+		}
+
+		resource := dcim.NewDcimModuleBaysPartialUpdateParams().WithID(resourceID).WithData(newResource)
+		if _, err := client.Dcim.DcimModuleBaysPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, moduleBayRequiredFields)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.FormatInt(resourceID, 10))
+
+		return resourceNetboxDcimModuleBayRead(ctx, d, m)
+	}
+
+	dropFields := []string{"installed_module"}
+	emptyFields := make(map[string]interface{})
+
+	resource := dcim.NewDcimModuleBaysCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimModuleBaysCreate(resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, dropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimModuleBayRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleBayRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimModuleBaysListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimModuleBaysList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var installedModuleID int64
+	if resource.InstalledModule != nil {
+		installedModuleID = resource.InstalledModule.ID
+	}
+	if err = d.Set("installed_module_id", installedModuleID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("position", resource.Position); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleBayUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableModuleBay{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("position") {
+		position := d.Get("position").(string)
+		params.Position = position
+		modifiedFields["position"] = position
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := dcim.NewDcimModuleBaysPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimModuleBaysPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, moduleBayRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimModuleBayRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleBayDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimModuleBayExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimModuleBaysDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimModuleBaysDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleBayExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimModuleBaysListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimModuleBaysList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,255 @@
+package dcim_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameNetboxDcimModule = "netbox_dcim_module.test"
+
+func TestAccNetboxDcimModuleMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimModule,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"adopt_components",
+					"replicate_components",
+				},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimModule, "interfaces.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceNameNetboxDcimModule, "interfaces.eth1/0"),
+				),
+			},
+			{
+				ResourceName:      resourceNameNetboxDcimModule,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"adopt_components",
+					"replicate_components",
+				},
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimModule, "interfaces.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceNameNetboxDcimModule, "interfaces.eth1/0"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+			{
+				Config: testAccCheckNetboxDcimModuleConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleNoReplication(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleComponentsConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimModule, "interfaces.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDcimModuleAdoption(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxDcimModuleComponentsConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxDcimModule),
+					resource.TestCheckResourceAttr(resourceNameNetboxDcimModule, "interfaces.%", "2"),
+					resource.TestCheckResourceAttrPair(resourceNameNetboxDcimModule, "interfaces.eth1/0",
+						"netbox_dcim_interface.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceNameNetboxDcimModule, "interfaces.eth1/1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetboxDcimModuleBaseConfig = `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "test" {
+		name           = "test-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_module_bay" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "Slot1"
+		position  = "1"
+	}
+
+	resource "netbox_dcim_module_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+
+		interface_template {
+			name = "eth{module}/0"
+			type = "10gbase-x-sfpp"
+		}
+
+		interface_template {
+			name = "eth{module}/1"
+			type = "10gbase-x-sfpp"
+		}
+	}
+`
+
+func testAccCheckNetboxDcimModuleConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := testAccNetboxDcimModuleBaseConfig + `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_module" "test" {
+		device_id      = netbox_dcim_device.test.id
+		module_bay_id  = netbox_dcim_module_bay.test.id
+		module_type_id = netbox_dcim_module_type.test.id
+		{{ if eq .resourcefull "true" }}
+		asset_tag = "test-{{ .namesuffix }}"
+		comments  = "Test module"
+		serial    = "SN-{{ .namesuffix }}"
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
+
+func testAccCheckNetboxDcimModuleComponentsConfig(nameSuffix string, adopt, replicate bool) string {
+	template := testAccNetboxDcimModuleBaseConfig + `
+	{{ if eq .adopt "true" }}
+	resource "netbox_dcim_interface" "test" {
+		device_id = netbox_dcim_device.test.id
+		name      = "eth1/0"
+		type      = "10gbase-x-sfpp"
+	}
+	{{ end }}
+
+	resource "netbox_dcim_module" "test" {
+		device_id            = netbox_dcim_device.test.id
+		module_bay_id        = netbox_dcim_module_bay.test.id
+		module_type_id       = netbox_dcim_module_type.test.id
+		adopt_components     = {{ .adopt }}
+		replicate_components = {{ .replicate }}
+		{{ if eq .adopt "true" }}
+		depends_on = [netbox_dcim_interface.test]
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+		"adopt":      strconv.FormatBool(adopt),
+		"replicate":  strconv.FormatBool(replicate),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,325 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+// Component templates of a module type, the referenced kinds come first.
+// Modules can't hold bays.
+var moduleTypeTemplateKinds = []*componentTemplateKind{
+	consolePortTemplateKind,
+	consoleServerPortTemplateKind,
+	powerPortTemplateKind,
+	powerOutletTemplateKind,
+	interfaceTemplateKind,
+	rearPortTemplateKind,
+	frontPortTemplateKind,
+}
+
+func ResourceNetboxDcimModuleType() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a module type (dcim module) and its component templates within Netbox. The {module} placeholder in the name of a template is replaced by the position of the module bay when a module is installed.",
+		CreateContext: resourceNetboxDcimModuleTypeCreate,
+		ReadContext:   resourceNetboxDcimModuleTypeRead,
+		UpdateContext: resourceNetboxDcimModuleTypeUpdate,
+		DeleteContext: resourceNetboxDcimModuleTypeDelete,
+		Exists:        resourceNetboxDcimModuleTypeExists,
+		CustomizeDiff: resourceNetboxDcimModuleTypeCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments for this module type (dcim module).",
+			},
+			"console_port_template":        consolePortTemplateKind.schema("The console port templates of this module type (dcim module)."),
+			"console_server_port_template": consoleServerPortTemplateKind.schema("The console server port templates of this module type (dcim module)."),
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this module type (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module type was created.",
+			},
+			"custom_field":        &customfield.CustomFieldSchema,
+			"front_port_template": frontPortTemplateKind.schema("The front port templates of this module type (dcim module)."),
+			"interface_template":  interfaceTemplateKind.schema("The interface templates of this module type (dcim module)."),
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this module type was last updated.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The manufacturer of this module type (dcim module).",
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The model of this module type (dcim module).",
+			},
+			"part_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The part number of this module type (dcim module).",
+			},
+			"power_outlet_template": powerOutletTemplateKind.schema("The power outlet templates of this module type (dcim module)."),
+			"power_port_template":   powerPortTemplateKind.schema("The power port templates of this module type (dcim module)."),
+			"rear_port_template":    rearPortTemplateKind.schema("The rear port templates of this module type (dcim module)."),
+			"tag":                   &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this module type (dcim module).",
+			},
+		},
+	}
+}
+
+var moduleTypeRequiredFields = []string{
+	"created",
+	"last_updated",
+	"manufacturer",
+	"model",
+	"tags",
+}
+
+// resourceNetboxDcimModuleTypeCustomizeDiff plans no templates for the kinds
+// without any block so that removing them from the configuration deletes
+// the templates
+func resourceNetboxDcimModuleTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	return planEmptyComponentTemplates(d, moduleTypeTemplateKinds)
+}
+
+func resourceNetboxDcimModuleTypeCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	model := d.Get("model").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.WritableModuleType{
+		Comments:     d.Get("comments").(string),
+		CustomFields: customFields,
+		Manufacturer: &manufacturerID,
+		Model:        &model,
+		PartNumber:   d.Get("part_number").(string),
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+
+	resource := dcim.NewDcimModuleTypesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimModuleTypesCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	parent := componentTemplateParent{moduleTypeID: resourceCreated.Payload.ID}
+	if err := reconcileComponentTemplates(client, parent, moduleTypeTemplateKinds, d.Get); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimModuleTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleTypeRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimModuleTypesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimModuleTypesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("comments", resource.Comments); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("manufacturer_id", util.GetNestedManufacturerID(resource.Manufacturer)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("model", resource.Model); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("part_number", resource.PartNumber); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	parent := componentTemplateParent{moduleTypeID: resource.ID}
+	templates, err := readComponentTemplates(client, parent, moduleTypeTemplateKinds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for key, value := range templates {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleTypeUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableModuleType{}
+
+	if d.HasChange("comments") {
+		comments := d.Get("comments").(string)
+		params.Comments = comments
+		modifiedFields["comments"] = comments
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("manufacturer_id") {
+		manufacturerID := int64(d.Get("manufacturer_id").(int))
+		params.Manufacturer = &manufacturerID
+	}
+	if d.HasChange("model") {
+		model := d.Get("model").(string)
+		params.Model = &model
+	}
+	if d.HasChange("part_number") {
+		partNumber := d.Get("part_number").(string)
+		params.PartNumber = partNumber
+		modifiedFields["part_number"] = partNumber
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := dcim.NewDcimModuleTypesPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimModuleTypesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, moduleTypeRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateKeys := []string{}
+	for _, kind := range moduleTypeTemplateKinds {
+		templateKeys = append(templateKeys, kind.key)
+	}
+	if d.HasChanges(templateKeys...) {
+		parent := componentTemplateParent{moduleTypeID: resourceID}
+		if err := reconcileComponentTemplates(client, parent, moduleTypeTemplateKinds, d.Get); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxDcimModuleTypeRead(ctx, d, m)
+}
+
+func resourceNetboxDcimModuleTypeDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimModuleTypeExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimModuleTypesDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimModuleTypesDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimModuleTypeExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimModuleTypesListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimModuleTypesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,401 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimPowerOutlet() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a power outlet of a device (dcim module) within Netbox. A power outlet created on the device from the templates of its device type or of the module type of one of its modules is adopted, its fields are overwritten. Any other existing power outlet with the same name is not adopted.",
+		CreateContext: resourceNetboxDcimPowerOutletCreate,
+		ReadContext:   resourceNetboxDcimPowerOutletRead,
+		UpdateContext: resourceNetboxDcimPowerOutletUpdate,
+		DeleteContext: resourceNetboxDcimPowerOutletDelete,
+		Exists:        resourceNetboxDcimPowerOutletExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/dcim/power-outlets/", "type"),
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("power outlet", listPowerOutletIDs),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this power outlet (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this power outlet was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this power outlet (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this power outlet (dcim module).",
+			},
+			"feed_leg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "B", "C"}, false),
+				Description:  "The phase of this power outlet (dcim module) among A, B or C.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this power outlet (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this power outlet was last updated.",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this power outlet (dcim module) is considered connected without a cable.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this power outlet (dcim module).",
+			},
+			"power_port_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The power port of the same device feeding this power outlet (dcim module).",
+			},
+			"tag": &tag.TagSchema,
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of this power outlet (dcim module), e.g. iec-60320-c13 or nema-5-15r.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this power outlet (dcim module).",
+			},
+		},
+	}
+}
+
+var powerOutletRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"name",
+	"tags",
+}
+
+// listPowerOutletIDs returns the IDs of the power outlets named name of the
+// device whose ID is deviceID or whose name is device
+func listPowerOutletIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimPowerOutletsListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimPowerOutletsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimPowerOutletCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	feedLeg := d.Get("feed_leg").(string)
+	label := d.Get("label").(string)
+	markConnected := d.Get("mark_connected").(bool)
+	name := d.Get("name").(string)
+	powerPortID := int64(d.Get("power_port_id").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	powerOutletType := d.Get("type").(string)
+
+	newResource := &models.WritablePowerOutlet{
+		CustomFields:  customFields,
+		Description:   description,
+		Device:        &deviceID,
+		FeedLeg:       feedLeg,
+		Label:         label,
+		MarkConnected: markConnected,
+		Name:          &name,
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		Type:          powerOutletType,
+	}
+	if powerPortID != 0 {
+		newResource.PowerPort = &powerPortID
+	}
+
+	// The power outlets created from the templates of the device type or of its
+	// modules are adopted, all their fields are overwritten
+	resourceID, err := templatedComponentID(client, powerOutletTemplateKind, listPowerOutletIDs, deviceID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resourceID != 0 {
+		modifiedFields := map[string]interface{}{
+			"description":    description,
+			"feed_leg":       feedLeg,
+			"label":          label,
+			"mark_connected": markConnected,
+			"power_port":     powerPortID,
+			"type":           powerOutletType,
+		}
+
+		resource := dcim.NewDcimPowerOutletsPartialUpdateParams().WithID(resourceID).WithData(newResource)
+		if _, err := client.Dcim.DcimPowerOutletsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, powerOutletRequiredFields)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.FormatInt(resourceID, 10))
+
+		return resourceNetboxDcimPowerOutletRead(ctx, d, m)
+	}
+
+	resource := dcim.NewDcimPowerOutletsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimPowerOutletsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimPowerOutletRead(ctx, d, m)
+}
+
+func resourceNetboxDcimPowerOutletRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimPowerOutletsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimPowerOutletsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var feedLeg string
+	if resource.FeedLeg != nil {
+		feedLeg = stringValue(resource.FeedLeg.Value)
+	}
+	if err = d.Set("feed_leg", feedLeg); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mark_connected", resource.MarkConnected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var powerPortID *int64
+	if resource.PowerPort != nil {
+		powerPortID = &resource.PowerPort.ID
+	}
+	if err = d.Set("power_port_id", powerPortID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var powerOutletType string
+	if resource.Type != nil {
+		powerOutletType = stringValue(resource.Type.Value)
+	}
+	if err = d.Set("type", powerOutletType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimPowerOutletUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritablePowerOutlet{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("feed_leg") {
+		feedLeg := d.Get("feed_leg").(string)
+		params.FeedLeg = feedLeg
+		modifiedFields["feed_leg"] = feedLeg
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("mark_connected") {
+		markConnected := d.Get("mark_connected").(bool)
+		params.MarkConnected = markConnected
+		modifiedFields["mark_connected"] = markConnected
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("power_port_id") {
+		powerPortID := int64(d.Get("power_port_id").(int))
+		params.PowerPort = &powerPortID
+		modifiedFields["power_port"] = powerPortID
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("type") {
+		powerOutletType := d.Get("type").(string)
+		params.Type = powerOutletType
+		modifiedFields["type"] = powerOutletType
+	}
+
+	resource := dcim.NewDcimPowerOutletsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimPowerOutletsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, powerOutletRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimPowerOutletRead(ctx, d, m)
+}
+
+func resourceNetboxDcimPowerOutletDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimPowerOutletExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimPowerOutletsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimPowerOutletsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimPowerOutletExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimPowerOutletsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimPowerOutletsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,393 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimPowerPort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a power port of a device (dcim module) within Netbox. A power port created on the device from the templates of its device type or of the module type of one of its modules is adopted, its fields are overwritten. Any other existing power port with the same name is not adopted.",
+		CreateContext: resourceNetboxDcimPowerPortCreate,
+		ReadContext:   resourceNetboxDcimPowerPortRead,
+		UpdateContext: resourceNetboxDcimPowerPortUpdate,
+		DeleteContext: resourceNetboxDcimPowerPortDelete,
+		Exists:        resourceNetboxDcimPowerPortExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/dcim/power-ports/", "type"),
+		Importer: &schema.ResourceImporter{
+			StateContext: importDeviceComponent("power port", listPowerPortIDs),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The allocated power draw of this power port (dcim module) in watts.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this power port (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this power port was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The description of this power port (dcim module).",
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device of this power port (dcim module).",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+				Description:  "The physical label of this power port (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this power port was last updated.",
+			},
+			"mark_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this power port (dcim module) is considered connected without a cable.",
+			},
+			"maximum_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum power draw of this power port (dcim module) in watts.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of this power port (dcim module).",
+			},
+			"tag": &tag.TagSchema,
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of this power port (dcim module), e.g. iec-60320-c14 or nema-5-15p.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this power port (dcim module).",
+			},
+		},
+	}
+}
+
+var powerPortRequiredFields = []string{
+	"created",
+	"last_updated",
+	"device",
+	"name",
+	"tags",
+}
+
+// listPowerPortIDs returns the IDs of the power ports named name of the
+// device whose ID is deviceID or whose name is device
+func listPowerPortIDs(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error) {
+	params := dcim.NewDcimPowerPortsListParams().WithDeviceID(deviceID).WithDevice(device).WithName(&name)
+	list, err := client.Dcim.DcimPowerPortsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, resource := range list.Payload.Results {
+		ids = append(ids, resource.ID)
+	}
+
+	return ids, nil
+}
+
+func resourceNetboxDcimPowerPortCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	allocatedDraw := int64(d.Get("allocated_draw").(int))
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	deviceID := int64(d.Get("device_id").(int))
+	label := d.Get("label").(string)
+	markConnected := d.Get("mark_connected").(bool)
+	maximumDraw := int64(d.Get("maximum_draw").(int))
+	name := d.Get("name").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+	powerPortType := d.Get("type").(string)
+
+	newResource := &models.WritablePowerPort{
+		CustomFields:  customFields,
+		Description:   description,
+		Device:        &deviceID,
+		Label:         label,
+		MarkConnected: markConnected,
+		Name:          &name,
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		Type:          powerPortType,
+	}
+	if allocatedDraw != 0 {
+		newResource.AllocatedDraw = &allocatedDraw
+	}
+	if maximumDraw != 0 {
+		newResource.MaximumDraw = &maximumDraw
+	}
+
+	// The power ports created from the templates of the device type or of its
+	// modules are adopted, all their fields are overwritten
+	resourceID, err := templatedComponentID(client, powerPortTemplateKind, listPowerPortIDs, deviceID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if resourceID != 0 {
+		modifiedFields := map[string]interface{}{
+			"allocated_draw": allocatedDraw,
+			"description":    description,
+			"label":          label,
+			"mark_connected": markConnected,
+			"maximum_draw":   maximumDraw,
+			"type":           powerPortType,
+		}
+
+		resource := dcim.NewDcimPowerPortsPartialUpdateParams().WithID(resourceID).WithData(newResource)
+		if _, err := client.Dcim.DcimPowerPortsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, powerPortRequiredFields)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(strconv.FormatInt(resourceID, 10))
+
+		return resourceNetboxDcimPowerPortRead(ctx, d, m)
+	}
+
+	resource := dcim.NewDcimPowerPortsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimPowerPortsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimPowerPortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimPowerPortRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimPowerPortsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimPowerPortsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("allocated_draw", resource.AllocatedDraw); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var deviceID *int64
+	if resource.Device != nil {
+		deviceID = &resource.Device.ID
+	}
+	if err = d.Set("device_id", deviceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("label", resource.Label); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mark_connected", resource.MarkConnected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("maximum_draw", resource.MaximumDraw); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var powerPortType string
+	if resource.Type != nil {
+		powerPortType = stringValue(resource.Type.Value)
+	}
+	if err = d.Set("type", powerPortType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritablePowerPort{}
+
+	if d.HasChange("allocated_draw") {
+		allocatedDraw := int64(d.Get("allocated_draw").(int))
+		params.AllocatedDraw = &allocatedDraw
+		modifiedFields["allocated_draw"] = allocatedDraw
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = description
+		modifiedFields["description"] = description
+	}
+	if d.HasChange("label") {
+		label := d.Get("label").(string)
+		params.Label = label
+		modifiedFields["label"] = label
+	}
+	if d.HasChange("mark_connected") {
+		markConnected := d.Get("mark_connected").(bool)
+		params.MarkConnected = markConnected
+		modifiedFields["mark_connected"] = markConnected
+	}
+	if d.HasChange("maximum_draw") {
+		maximumDraw := int64(d.Get("maximum_draw").(int))
+		params.MaximumDraw = &maximumDraw
+		modifiedFields["maximum_draw"] = maximumDraw
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("type") {
+		powerPortType := d.Get("type").(string)
+		params.Type = powerPortType
+		modifiedFields["type"] = powerPortType
+	}
+
+	resource := dcim.NewDcimPowerPortsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimPowerPortsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, powerPortRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimPowerPortRead(ctx, d, m)
+}
+
+func resourceNetboxDcimPowerPortDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimPowerPortExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimPowerPortsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimPowerPortsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimPowerPortsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimPowerPortsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
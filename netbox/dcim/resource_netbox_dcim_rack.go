@@ -0,0 +1,520 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimRack() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a rack (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimRackCreate,
+		ReadContext:   resourceNetboxDcimRackRead,
+		UpdateContext: resourceNetboxDcimRackUpdate,
+		DeleteContext: resourceNetboxDcimRackDelete,
+		Exists:        resourceNetboxDcimRackExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asset_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+				Description:  "A unique tag used to identify this rack (dcim module).",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   util.TrimString,
+				Description: "Comments for this rack (dcim module).",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this rack (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this rack was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"desc_units": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Units of this rack (dcim module) are numbered top-to-bottom.",
+			},
+			"device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices in this rack (dcim module).",
+			},
+			"facility_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+				Description:  "The locally-assigned identifier of this rack (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this rack was last updated.",
+			},
+			"location_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The location of this rack (dcim module), it must belong to the site of the rack.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The name of this rack (dcim module).",
+			},
+			"outer_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "The outer depth of this rack (dcim module).",
+			},
+			"outer_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"mm", "in"}, false),
+				Description:  "The unit of the outer dimensions of this rack (dcim module). Allowed values: \"mm\", \"in\".",
+			},
+			"outer_width": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "The outer width of this rack (dcim module).",
+			},
+			"powerfeed_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of power feeds of this rack (dcim module).",
+			},
+			"role_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The role of this rack (dcim module).",
+			},
+			"serial": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The serial number of this rack (dcim module).",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The site of this rack (dcim module).",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"reserved", "available", "planned", "active", "deprecated"}, false),
+				Description:  "The status of this rack (dcim module). Allowed values: \"active\" (default), \"reserved\", \"available\", \"planned\", \"deprecated\".",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The tenant of this rack (dcim module).",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"2-post-frame", "4-post-frame", "4-post-cabinet",
+					"wall-frame", "wall-frame-vertical", "wall-cabinet", "wall-cabinet-vertical"}, false),
+				Description: "The type of this rack (dcim module). Allowed values: \"2-post-frame\", \"4-post-frame\", \"4-post-cabinet\", \"wall-frame\", \"wall-frame-vertical\", \"wall-cabinet\", \"wall-cabinet-vertical\".",
+			},
+			"u_height": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      42,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The height in rack units of this rack (dcim module). Default is 42.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this rack (dcim module).",
+			},
+			"width": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      19,
+				ValidateFunc: validation.IntInSlice([]int{10, 19, 21, 23}),
+				Description:  "The rail-to-rail width in inches of this rack (dcim module). Allowed values: 10, 19 (default), 21, 23.",
+			},
+		},
+	}
+}
+
+var rackRequiredFields = []string{
+	"created",
+	"last_updated",
+	"location",
+	"name",
+	"site",
+	"tags",
+}
+
+func resourceNetboxDcimRackCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	assetTag := d.Get("asset_tag").(string)
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	facilityID := d.Get("facility_id").(string)
+	locationID := int64(d.Get("location_id").(int))
+	name := d.Get("name").(string)
+	outerDepth := int64(d.Get("outer_depth").(int))
+	outerWidth := int64(d.Get("outer_width").(int))
+	roleID := int64(d.Get("role_id").(int))
+	siteID := int64(d.Get("site_id").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	tenantID := int64(d.Get("tenant_id").(int))
+
+	newResource := &models.WritableRack{
+		Comments:     d.Get("comments").(string),
+		CustomFields: customFields,
+		DescUnits:    d.Get("desc_units").(bool),
+		Name:         &name,
+		OuterUnit:    d.Get("outer_unit").(string),
+		Serial:       d.Get("serial").(string),
+		Site:         &siteID,
+		Status:       d.Get("status").(string),
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+		Type:         d.Get("type").(string),
+		UHeight:      int64(d.Get("u_height").(int)),
+		Width:        int64(d.Get("width").(int)),
+	}
+	if assetTag != "" {
+		newResource.AssetTag = &assetTag
+	}
+	if facilityID != "" {
+		newResource.FacilityID = &facilityID
+	}
+	if locationID != 0 {
+		newResource.Location = &locationID
+	}
+	if outerDepth != 0 {
+		newResource.OuterDepth = &outerDepth
+	}
+	if outerWidth != 0 {
+		newResource.OuterWidth = &outerWidth
+	}
+	if roleID != 0 {
+		newResource.Role = &roleID
+	}
+	if tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+
+	resource := dcim.NewDcimRacksCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimRacksCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimRackRead(ctx, d, m)
+}
+
+func resourceNetboxDcimRackRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimRacksListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimRacksList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("asset_tag", resource.AssetTag); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comments", resource.Comments); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("desc_units", resource.DescUnits); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("device_count", resource.DeviceCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("facility_id", resource.FacilityID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("location_id", util.GetNestedLocationID(resource.Location)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("outer_depth", resource.OuterDepth); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var outerUnit *string
+	if resource.OuterUnit != nil {
+		outerUnit = resource.OuterUnit.Value
+	}
+	if err = d.Set("outer_unit", outerUnit); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("outer_width", resource.OuterWidth); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("powerfeed_count", resource.PowerfeedCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("role_id", util.GetNestedRackRoleID(resource.Role)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("serial", resource.Serial); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("site_id", util.GetNestedSiteID(resource.Site)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", resource.Status.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var rackType *string
+	if resource.Type != nil {
+		rackType = resource.Type.Value
+	}
+	if err = d.Set("type", rackType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("u_height", resource.UHeight); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var width *int64
+	if resource.Width != nil {
+		width = resource.Width.Value
+	}
+	if err = d.Set("width", width); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimRackUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableRack{}
+
+	if d.HasChange("asset_tag") {
+		assetTag := d.Get("asset_tag").(string)
+		params.AssetTag = &assetTag
+		if assetTag == "" {
+			modifiedFields["asset_tag"] = nil
+		}
+	}
+	if d.HasChange("comments") {
+		comments := d.Get("comments").(string)
+		params.Comments = comments
+		modifiedFields["comments"] = comments
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("desc_units") {
+		descUnits := d.Get("desc_units").(bool)
+		params.DescUnits = descUnits
+		modifiedFields["desc_units"] = descUnits
+	}
+	if d.HasChange("facility_id") {
+		facilityID := d.Get("facility_id").(string)
+		params.FacilityID = &facilityID
+		if facilityID == "" {
+			modifiedFields["facility_id"] = nil
+		}
+	}
+	if d.HasChange("location_id") {
+		locationID := int64(d.Get("location_id").(int))
+		params.Location = &locationID
+		modifiedFields["location"] = locationID
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("outer_depth") {
+		outerDepth := int64(d.Get("outer_depth").(int))
+		params.OuterDepth = &outerDepth
+		modifiedFields["outer_depth"] = outerDepth
+	}
+	if d.HasChange("outer_unit") {
+		outerUnit := d.Get("outer_unit").(string)
+		params.OuterUnit = outerUnit
+		modifiedFields["outer_unit"] = outerUnit
+	}
+	if d.HasChange("outer_width") {
+		outerWidth := int64(d.Get("outer_width").(int))
+		params.OuterWidth = &outerWidth
+		modifiedFields["outer_width"] = outerWidth
+	}
+	if d.HasChange("role_id") {
+		roleID := int64(d.Get("role_id").(int))
+		params.Role = &roleID
+		modifiedFields["role"] = roleID
+	}
+	if d.HasChange("serial") {
+		serial := d.Get("serial").(string)
+		params.Serial = serial
+		modifiedFields["serial"] = serial
+	}
+	if d.HasChange("site_id") {
+		siteID := int64(d.Get("site_id").(int))
+		params.Site = &siteID
+	}
+	if d.HasChange("status") {
+		params.Status = d.Get("status").(string)
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+	if d.HasChange("type") {
+		rackType := d.Get("type").(string)
+		params.Type = rackType
+		modifiedFields["type"] = rackType
+	}
+	if d.HasChange("u_height") {
+		params.UHeight = int64(d.Get("u_height").(int))
+	}
+	if d.HasChange("width") {
+		params.Width = int64(d.Get("width").(int))
+	}
+
+	resource := dcim.NewDcimRacksPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimRacksPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, rackRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimRackRead(ctx, d, m)
+}
+
+func resourceNetboxDcimRackDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimRackExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimRacksDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimRacksDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimRackExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimRacksListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimRacksList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
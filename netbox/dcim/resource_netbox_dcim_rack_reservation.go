@@ -0,0 +1,298 @@
+package dcim
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxDcimRackReservation() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a rack reservation (dcim module) within Netbox.",
+		CreateContext: resourceNetboxDcimRackReservationCreate,
+		ReadContext:   resourceNetboxDcimRackReservationRead,
+		UpdateContext: resourceNetboxDcimRackReservationUpdate,
+		DeleteContext: resourceNetboxDcimRackReservationDelete,
+		Exists:        resourceNetboxDcimRackReservationExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this rack reservation (dcim module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this rack reservation was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+				Description:  "The description of this rack reservation (dcim module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this rack reservation was last updated.",
+			},
+			"rack_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The rack of this rack reservation (dcim module).",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The tenant of this rack reservation (dcim module).",
+			},
+			"units": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				Description: "The units of the rack reserved by this rack reservation (dcim module).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this rack reservation (dcim module).",
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The user owning this rack reservation (dcim module).",
+			},
+		},
+	}
+}
+
+var rackReservationRequiredFields = []string{
+	"created",
+	"last_updated",
+	"description",
+	"rack",
+	"tags",
+	"units",
+	"user",
+}
+
+func resourceNetboxDcimRackReservationCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	description := d.Get("description").(string)
+	rackID := int64(d.Get("rack_id").(int))
+	tags := d.Get("tag").(*schema.Set).List()
+	tenantID := int64(d.Get("tenant_id").(int))
+	units := d.Get("units").(*schema.Set).List()
+	userID := int64(d.Get("user_id").(int))
+
+	newResource := &models.WritableRackReservation{
+		CustomFields: customFields,
+		Description:  &description,
+		Rack:         &rackID,
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+		Units:        util.ToListofIntPointers(units),
+		User:         &userID,
+	}
+	if tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+
+	resource := dcim.NewDcimRackReservationsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Dcim.DcimRackReservationsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxDcimRackReservationRead(ctx, d, m)
+}
+
+func resourceNetboxDcimRackReservationRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := dcim.NewDcimRackReservationsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimRackReservationsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rack_id", util.GetNestedRackID(resource.Rack)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("units", util.FromListofIntPointers(resource.Units)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var userID *int64
+	if resource.User != nil {
+		userID = &resource.User.ID
+	}
+	if err = d.Set("user_id", userID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimRackReservationUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableRackReservation{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		params.Description = &description
+	}
+	if d.HasChange("rack_id") {
+		rackID := int64(d.Get("rack_id").(int))
+		params.Rack = &rackID
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+	if d.HasChange("units") {
+		units := d.Get("units").(*schema.Set).List()
+		params.Units = util.ToListofIntPointers(units)
+	}
+	if d.HasChange("user_id") {
+		userID := int64(d.Get("user_id").(int))
+		params.User = &userID
+	}
+
+	resource := dcim.NewDcimRackReservationsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Dcim.DcimRackReservationsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, rackReservationRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDcimRackReservationRead(ctx, d, m)
+}
+
+func resourceNetboxDcimRackReservationDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxDcimRackReservationExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := dcim.NewDcimRackReservationsDeleteParams().WithID(id)
+	if _, err := client.Dcim.DcimRackReservationsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxDcimRackReservationExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := dcim.NewDcimRackReservationsListParams().WithID(&resourceID)
+	resources, err := client.Dcim.DcimRackReservationsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,305 @@
+package dcim
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+)
+
+// Default time to wait for the children of a region, a site group, a
+// location or an inventory item to be deleted before deleting it
+const childrenDeleteTimeout = time.Minute
+
+// Schema of the parent chain of a region, a site group or a location
+var parentsSchema = schema.Schema{
+	Type:        schema.TypeList,
+	Computed:    true,
+	Description: "The parents of this object, from the root of the tree to the direct parent.",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of this parent.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of this parent.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The slug of this parent.",
+			},
+		},
+	},
+}
+
+// parentReader reads the object id of a tree and returns its id, name and
+// slug along with the ID of its own parent (nil for a root)
+type parentReader func(id int64) (map[string]interface{}, *int64, error)
+
+// getParents walks the tree up from parentID and returns the parents from
+// the root to parentID
+func getParents(parentID *int64, read parentReader) ([]map[string]interface{}, error) {
+	parents := []map[string]interface{}{}
+
+	for parentID != nil {
+		parent, nextID, err := read(*parentID)
+		if err != nil {
+			return nil, err
+		}
+		parents = append([]map[string]interface{}{parent}, parents...)
+		parentID = nextID
+	}
+
+	return parents, nil
+}
+
+// waitForNoChildren waits until countChildren returns 0. Netbox deletes the
+// children of a region, a site group, a location or an inventory item along
+// with it, so the deletion is refused when children remain. Children deleted
+// by the same run are given the time to go away first.
+func waitForNoChildren(ctx context.Context, timeout time.Duration, objectType string,
+	id int64, countChildren func() (int64, error)) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		count, err := countChildren()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if count > 0 {
+			return resource.RetryableError(fmt.Errorf(
+				"%s with ID %d still has %d child(ren), delete them before deleting it",
+				objectType, id, count))
+		}
+
+		return nil
+	})
+}
+
+// countRegionChildren returns the number of regions whose parent is id
+func countRegionChildren(client *netboxclient.NetBoxAPI, id int64) (int64, error) {
+	idStr := strconv.FormatInt(id, 10)
+	limit := int64(1)
+	params := dcim.NewDcimRegionsListParams().WithParentID(&idStr).WithLimit(&limit)
+	list, err := client.Dcim.DcimRegionsList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return *list.Payload.Count, nil
+}
+
+// countSiteGroupChildren returns the number of site groups whose parent is id
+func countSiteGroupChildren(client *netboxclient.NetBoxAPI, id int64) (int64, error) {
+	idStr := strconv.FormatInt(id, 10)
+	limit := int64(1)
+	params := dcim.NewDcimSiteGroupsListParams().WithParentID(&idStr).WithLimit(&limit)
+	list, err := client.Dcim.DcimSiteGroupsList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return *list.Payload.Count, nil
+}
+
+// countLocationChildren returns the number of locations whose parent is id
+func countLocationChildren(client *netboxclient.NetBoxAPI, id int64) (int64, error) {
+	idStr := strconv.FormatInt(id, 10)
+	limit := int64(1)
+	params := dcim.NewDcimLocationsListParams().WithParentID(&idStr).WithLimit(&limit)
+	list, err := client.Dcim.DcimLocationsList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return *list.Payload.Count, nil
+}
+
+// countInventoryItemChildren returns the number of inventory items whose
+// parent is id
+func countInventoryItemChildren(client *netboxclient.NetBoxAPI, id int64) (int64, error) {
+	idStr := strconv.FormatInt(id, 10)
+	limit := int64(1)
+	params := dcim.NewDcimInventoryItemsListParams().WithParentID(&idStr).WithLimit(&limit)
+	list, err := client.Dcim.DcimInventoryItemsList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return *list.Payload.Count, nil
+}
+
+// countInventoryItemTemplateChildren returns the number of inventory item
+// templates whose parent is id
+func countInventoryItemTemplateChildren(client *netboxclient.NetBoxAPI, id int64) (int64, error) {
+	idStr := strconv.FormatInt(id, 10)
+	limit := int64(1)
+	params := dcim.NewDcimInventoryItemTemplatesListParams().WithParentID(&idStr).WithLimit(&limit)
+	list, err := client.Dcim.DcimInventoryItemTemplatesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return *list.Payload.Count, nil
+}
+
+// deviceComponentLister returns the IDs of the components named name of the
+// device whose ID is deviceID or whose name is device
+type deviceComponentLister func(client *netboxclient.NetBoxAPI, deviceID, device *string,
+	name string) ([]int64, error)
+
+// importDeviceComponent returns the importer of the components of a device
+// of kind, which accepts the ID of the component or
+// <device name>/<component name>
+func importDeviceComponent(kind string, list deviceComponentLister) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData,
+		m interface{}) ([]*schema.ResourceData, error) {
+		client := m.(*netboxclient.NetBoxAPI)
+
+		if _, err := strconv.ParseInt(d.Id(), 10, 64); err == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		parts := strings.SplitN(d.Id(), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected import ID %s, expected <ID> or <device>/<%s name>", d.Id(), kind)
+		}
+
+		ids, err := list(client, nil, &parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("%s %s of device %s not found", kind, parts[1], parts[0])
+		}
+		// Device names are only unique within a site and a tenant
+		if len(ids) > 1 {
+			return nil, fmt.Errorf("%d %ss named %s found on the devices named %s, import it by ID",
+				len(ids), kind, parts[1], parts[0])
+		}
+
+		d.SetId(strconv.FormatInt(ids[0], 10))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// templatedComponentID returns the ID of the component of kind named name of
+// the device deviceID when a template of its device type or of the module
+// type of one of its modules has this name, 0 otherwise. Only the components
+// created by Netbox from the templates are adopted, not the ones created by
+// hand.
+func templatedComponentID(client *netboxclient.NetBoxAPI, kind *componentTemplateKind,
+	list deviceComponentLister, deviceID int64, name string) (int64, error) {
+	deviceIDFilter := strconv.FormatInt(deviceID, 10)
+	ids, err := list(client, &deviceIDFilter, nil, name)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) != 1 {
+		return 0, nil
+	}
+
+	names, err := componentTemplateNames(client, kind, deviceID)
+	if err != nil {
+		return 0, err
+	}
+	if !names[name] {
+		return 0, nil
+	}
+
+	return ids[0], nil
+}
+
+// componentTemplateNames returns the names of the components of kind created
+// on the device deviceID from the templates of its device type and of the
+// module types of its modules
+func componentTemplateNames(client *netboxclient.NetBoxAPI, kind *componentTemplateKind,
+	deviceID int64) (map[string]bool, error) {
+	names := map[string]bool{}
+
+	device, err := client.Dcim.DcimDevicesRead(dcim.NewDcimDevicesReadParams().WithID(deviceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if device.Payload.DeviceType != nil {
+		templates, err := kind.list(client, componentTemplateParent{deviceTypeID: device.Payload.DeviceType.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, template := range templates {
+			names[template["name"].(string)] = true
+		}
+	}
+
+	inModules := false
+	for _, k := range moduleTypeTemplateKinds {
+		if k == kind {
+			inModules = true
+		}
+	}
+	if !inModules {
+		return names, nil
+	}
+
+	deviceIDFilter := strconv.FormatInt(deviceID, 10)
+
+	positions := map[int64]string{}
+	bayParams := dcim.NewDcimModuleBaysListParams().WithDeviceID(&deviceIDFilter)
+	err = readAllPages(func(limit, offset *int64) (int64, int, error) {
+		list, err := client.Dcim.DcimModuleBaysList(bayParams.WithLimit(limit).WithOffset(offset), nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, bay := range list.Payload.Results {
+			positions[bay.ID] = bay.Position
+		}
+		return *list.Payload.Count, len(list.Payload.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	modules := []*models.Module{}
+	moduleParams := dcim.NewDcimModulesListParams().WithDeviceID(&deviceIDFilter)
+	err = readAllPages(func(limit, offset *int64) (int64, int, error) {
+		list, err := client.Dcim.DcimModulesList(moduleParams.WithLimit(limit).WithOffset(offset), nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		modules = append(modules, list.Payload.Results...)
+		return *list.Payload.Count, len(list.Payload.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The {module} placeholder in the name of the templates of a module type
+	// is replaced by the position of the module bay
+	for _, module := range modules {
+		if module.ModuleType == nil || module.ModuleBay == nil {
+			continue
+		}
+		templates, err := kind.list(client, componentTemplateParent{moduleTypeID: module.ModuleType.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, template := range templates {
+			name := strings.ReplaceAll(template["name"].(string), "{module}", positions[module.ModuleBay.ID])
+			names[name] = true
+		}
+	}
+
+	return names, nil
+}
@@ -98,6 +98,36 @@ func convertArrayInterfaceJSONString(arrayInterface []interface{}) string {
 	return result
 }
 
+var ComputedCustomFieldsSchema = schema.Schema{
+	Type:     schema.TypeMap,
+	Computed: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Description: "Custom fields of this object, the values which are not strings are JSON encoded.",
+}
+
+// Convert all the custom fields returned by the API into a map of strings
+func ConvertCustomFieldsFromAPIToMap(customFields interface{}) map[string]string {
+	cfs := map[string]string{}
+
+	if t, ok := customFields.(map[string]interface{}); ok {
+		for key, value := range t {
+			switch v := value.(type) {
+			case nil:
+				cfs[key] = ""
+			case string:
+				cfs[key] = v
+			default:
+				jsonValue, _ := json.Marshal(v)
+				cfs[key] = string(jsonValue)
+			}
+		}
+	}
+
+	return cfs
+}
+
 // Pick the custom fields in the state file and update values with data from API
 func UpdateCustomFieldsFromAPI(stateCustomFields, customFields interface{}) []map[string]string {
 	var tfCms []map[string]string
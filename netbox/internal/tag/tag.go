@@ -25,6 +25,26 @@ var TagSchema = schema.Schema{
 	Description: "Existing tag to associate to this resource.",
 }
 
+var ComputedTagSchema = schema.Schema{
+	Type:     schema.TypeSet,
+	Computed: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the tag.",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Slug of the tag.",
+			},
+		},
+	},
+	Description: "Tags associated to this object.",
+}
+
 func ConvertTagsToNestedTags(tags []interface{}) []*models.NestedTag {
 	nestedTags := []*models.NestedTag{}
 
@@ -0,0 +1,116 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+)
+
+// Cache of the choices returned by the OPTIONS requests, indexed by client
+// and path
+var choicesCache sync.Map
+
+type optionsChoice struct {
+	Value string `json:"value"`
+}
+
+type optionsField struct {
+	Choices []optionsChoice `json:"choices"`
+}
+
+type optionsResponse struct {
+	Actions struct {
+		POST map[string]optionsField `json:"POST"`
+	} `json:"actions"`
+}
+
+// GetChoices returns the values allowed by Netbox for field on the objects
+// of the API endpoint path (e.g. /ipam/prefixes/). The values are read from
+// the OPTIONS metadata so the choices extended with FIELD_CHOICES are
+// included.
+func GetChoices(client *netboxclient.NetBoxAPI, path, field string) ([]string, error) {
+	key := fmt.Sprintf("%p%s", client, path)
+	fields, ok := choicesCache.Load(key)
+	if !ok {
+		op := &runtime.ClientOperation{
+			ID:                 "options" + strings.ReplaceAll(path, "/", "_"),
+			Method:             "OPTIONS",
+			PathPattern:        path,
+			ProducesMediaTypes: []string{"application/json"},
+			ConsumesMediaTypes: []string{"application/json"},
+			Schemes:            []string{"http"},
+			Params: runtime.ClientRequestWriterFunc(func(runtime.ClientRequest, strfmt.Registry) error {
+				return nil
+			}),
+			Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse,
+				consumer runtime.Consumer) (interface{}, error) {
+				if response.Code()/100 != 2 {
+					return nil, runtime.NewAPIError("OPTIONS "+path, response.Message(), response.Code())
+				}
+				result := &optionsResponse{}
+				if err := consumer.Consume(response.Body(), result); err != nil {
+					return nil, err
+				}
+				return result, nil
+			}),
+		}
+
+		result, err := client.Transport.Submit(op)
+		if err != nil {
+			return nil, err
+		}
+		fields, _ = choicesCache.LoadOrStore(key, result.(*optionsResponse).Actions.POST)
+	}
+
+	var choices []string
+	for _, choice := range fields.(map[string]optionsField)[field].Choices {
+		choices = append(choices, choice.Value)
+	}
+
+	return choices, nil
+}
+
+// CustomizeDiffChoices returns a CustomizeDiffFunc checking that the values
+// of fields are among the choices allowed by Netbox for the objects of the
+// API endpoint path. The check is skipped when the choices can't be fetched,
+// Netbox validates the values anyway.
+func CustomizeDiffChoices(path string, fields ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		client, ok := m.(*netboxclient.NetBoxAPI)
+		if !ok {
+			return nil
+		}
+
+		for _, field := range fields {
+			value := d.Get(field).(string)
+			if value == "" || !d.NewValueKnown(field) {
+				continue
+			}
+
+			choices, err := GetChoices(client, path, field)
+			if err != nil || len(choices) == 0 {
+				continue
+			}
+
+			found := false
+			for _, choice := range choices {
+				if choice == value {
+					found = true
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected %s to be one of [%s], got %s", field,
+					strings.Join(choices, ", "), value)
+			}
+		}
+
+		return nil
+	}
+}
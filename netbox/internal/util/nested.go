@@ -12,6 +12,14 @@ func GetNestedIPAddressAddress(nested *models.NestedIPAddress) *string {
 	return nested.Address
 }
 
+func GetNestedClusterID(nested *models.NestedCluster) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
 func GetNestedClusterGroupID(nested *models.NestedClusterGroup) *int64 {
 	if nested == nil {
 		return nil
@@ -20,6 +28,38 @@ func GetNestedClusterGroupID(nested *models.NestedClusterGroup) *int64 {
 	return &nested.ID
 }
 
+func GetNestedDeviceTypeID(nested *models.NestedDeviceType) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedInterfaceID(nested *models.NestedInterface) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedInventoryItemRoleID(nested *models.NestedInventoryItemRole) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedLocationID(nested *models.NestedLocation) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
 func GetNestedManufacturerID(nested *models.NestedManufacturer) *int64 {
 	if nested == nil {
 		return nil
@@ -28,6 +68,22 @@ func GetNestedManufacturerID(nested *models.NestedManufacturer) *int64 {
 	return &nested.ID
 }
 
+func GetNestedModuleBayID(nested *models.NestedModuleBay) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedModuleTypeID(nested *models.NestedModuleType) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
 func GetNestedPlatformID(nested *models.NestedPlatform) *int64 {
 	if nested == nil {
 		return nil
@@ -36,6 +92,22 @@ func GetNestedPlatformID(nested *models.NestedPlatform) *int64 {
 	return &nested.ID
 }
 
+func GetNestedRackID(nested *models.NestedRack) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedRackRoleID(nested *models.NestedRackRole) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
 func GetNestedRegionID(nested *models.NestedRegion) *int64 {
 	if nested == nil {
 		return nil
@@ -68,6 +140,30 @@ func GetNestedTenantID(nested *models.NestedTenant) *int64 {
 	return &nested.ID
 }
 
+func GetNestedVLANID(nested *models.NestedVLAN) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedVirtualChassisID(nested *models.NestedVirtualChassis) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
+func GetNestedVRFID(nested *models.NestedVRF) *int64 {
+	if nested == nil {
+		return nil
+	}
+
+	return &nested.ID
+}
+
 func GetNestedRoleID(nested *models.NestedDeviceRole) *int64 {
 	if nested == nil {
 		return nil
@@ -23,6 +23,18 @@ func ConvertNestedASNsToASNs(asns []*models.NestedASN) []int64 {
 	return tfASNs
 }
 
+func ConvertNestedRouteTargetsToRouteTargets(routeTargets []*models.NestedRouteTarget) []int64 {
+	var tfRouteTargets []int64
+
+	for _, t := range routeTargets {
+		routeTarget := t.ID
+
+		tfRouteTargets = append(tfRouteTargets, routeTarget)
+	}
+
+	return tfRouteTargets
+}
+
 // Convert URL in content_type
 func ConvertURIContentType(uri strfmt.URI) string {
 	uriSplit := strings.Split(uri.String(), "/")
@@ -77,6 +89,23 @@ func ToListofInts(in []interface{}) []int64 {
 	return out
 }
 
+func ToListofIntPointers(in []interface{}) []*int64 {
+	out := make([]*int64, len(in))
+	for i := range in {
+		value := int64(in[i].(int))
+		out[i] = &value
+	}
+	return out
+}
+
+func FromListofIntPointers(in []*int64) []int64 {
+	out := make([]int64, len(in))
+	for i := range in {
+		out[i] = *in[i]
+	}
+	return out
+}
+
 func ToListofStrings(in []interface{}) []string {
 	out := make([]string, len(in))
 	for i := range in {
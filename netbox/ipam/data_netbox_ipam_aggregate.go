@@ -2,6 +2,7 @@ package ipam
 
 import (
 	"context"
+	"net/netip"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -9,6 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
 	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
 )
 
@@ -18,22 +22,54 @@ func DataNetboxIpamAggregate() *schema.Resource {
 		ReadContext: dataNetboxIpamAggregateRead,
 
 		Schema: map[string]*schema.Schema{
+			"contains": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"contains", "prefix"},
+				ValidateFunc: validation.IsCIDR,
+				Description:  "A prefix or an IP address (with mask) contained in the aggregate (ipam module) to look up. Required if prefix is not set.",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The content type of this aggregate (ipam module).",
 			},
+			"custom_fields": &customfield.ComputedCustomFieldsSchema,
+			"date_added": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this aggregate (ipam module) was added.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this aggregate (ipam module).",
+			},
+			"family": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The IP family of this aggregate (ipam module), 4 or 6.",
+			},
 			"prefix": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IsCIDRNetwork(0, 256),
-				Description:  "The prefix (with mask) used for this aggregate (ipam module).",
+				ExactlyOneOf: []string{"contains", "prefix"},
+				Description:  "The prefix (with mask) used for this aggregate (ipam module). Required if contains is not set.",
 			},
 			"rir_id": {
 				Type:        schema.TypeInt,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "The RIR id linked to this aggregate (ipam module).",
 			},
+			"tag": &tag.ComputedTagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the tenant where this aggregate (ipam module) is attached.",
+			},
 		},
 	}
 }
@@ -42,29 +78,106 @@ func dataNetboxIpamAggregateRead(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
 
-	prefix := d.Get("prefix").(string)
-	rirID := d.Get("rir_id").(string)
+	p := ipam.NewIpamAggregatesListParams()
+
+	if rirID, ok := d.GetOk("rir_id"); ok {
+		rirIDStr := strconv.Itoa(rirID.(int))
+		p.SetRirID(&rirIDStr)
+	}
+
+	var contains netip.Prefix
+	if containsStr, ok := d.GetOk("contains"); ok {
+		var err error
+		contains, err = netip.ParsePrefix(containsStr.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	p := ipam.NewIpamAggregatesListParams().WithPrefix(&prefix).WithRirID(&rirID)
+		// The search of Netbox matches the aggregates containing the prefix and
+		// the ones whose description contains it, the latter are filtered below
+		q := contains.Masked().String()
+		p.SetQ(&q)
+	} else {
+		prefix := d.Get("prefix").(string)
+		p.SetPrefix(&prefix)
+	}
 
 	list, err := client.Ipam.IpamAggregatesList(p, nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if *list.Payload.Count < 1 {
+	results := list.Payload.Results
+	if contains.IsValid() {
+		results = []*models.Aggregate{}
+		for _, r := range list.Payload.Results {
+			aggregate, err := netip.ParsePrefix(*r.Prefix)
+			if err == nil && aggregate.Bits() <= contains.Bits() && aggregate.Contains(contains.Addr()) {
+				results = append(results, r)
+			}
+		}
+	}
+
+	if len(results) < 1 {
 		return diag.Errorf("Your query returned no results. " +
 			"Please change your search criteria and try again.")
-	} else if *list.Payload.Count > 1 {
+	} else if len(results) > 1 {
 		return diag.Errorf("Your query returned more than one result. " +
 			"Please try a more specific search criteria.")
 	}
 
-	r := list.Payload.Results[0]
+	r := results[0]
 	d.SetId(strconv.FormatInt(r.ID, 10))
 	if err = d.Set("content_type", util.ConvertURIContentType(r.URL)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("custom_fields", customfield.ConvertCustomFieldsFromAPIToMap(r.CustomFields)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var dateAdded string
+	if r.DateAdded != nil {
+		dateAdded = r.DateAdded.String()
+	}
+	if err = d.Set("date_added", dateAdded); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("description", r.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var family int64
+	if r.Family != nil && r.Family.Value != nil {
+		family = *r.Family.Value
+	}
+	if err = d.Set("family", family); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("prefix", r.Prefix); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var rirID int64
+	if r.Rir != nil {
+		rirID = r.Rir.ID
+	}
+	if err = d.Set("rir_id", rirID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(r.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var tenantID int64
+	if r.Tenant != nil {
+		tenantID = r.Tenant.ID
+	}
+	if err = d.Set("tenant_id", tenantID); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
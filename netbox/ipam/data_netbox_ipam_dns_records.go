@@ -0,0 +1,242 @@
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+)
+
+// Number of IP addresses read per request when building the DNS records
+const dnsRecordsPageSize int64 = 1000
+
+func DataNetboxIpamDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the DNS records (A, AAAA and PTR) of the IP addresses (ipam module) having a DNS name from netbox.",
+		ReadContext: dataNetboxIpamDNSRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"forward_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The A and AAAA records of the IP addresses (ipam module).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the IP address (ipam module) of this record.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of this record, the DNS name of the IP address.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of this record, A or AAAA.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of this record, the IP address without mask.",
+						},
+					},
+				},
+			},
+			"ipv4_reverse_zone_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntInSlice([]int{8, 16, 24}),
+				Description:  "The prefix length of the IPv4 reverse zones among 8, 16 or 24 (24 by default).",
+			},
+			"ipv6_reverse_zone_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  64,
+				ValidateFunc: validation.All(validation.IntBetween(4, 124),
+					validation.IntDivisibleBy(4)),
+				Description: "The prefix length of the IPv6 reverse zones, a multiple of 4 (64 by default).",
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDRNetwork(0, 128),
+				Description:  "Only the IP addresses (ipam module) within this prefix are used.",
+			},
+			"reverse_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The PTR records of the IP addresses (ipam module).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the IP address (ipam module) of this record.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of this record in the in-addr.arpa or ip6.arpa domain.",
+						},
+						"reverse_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reverse zone of this record.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of this record, the DNS name of the IP address.",
+						},
+					},
+				},
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the IP addresses (ipam module) with the tag having this slug are used.",
+			},
+			"vrf_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only the IP addresses (ipam module) of the VRF with this ID are used.",
+			},
+		},
+	}
+}
+
+func dataNetboxIpamDNSRecordsRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	ipv4ZoneLength := d.Get("ipv4_reverse_zone_length").(int)
+	ipv6ZoneLength := d.Get("ipv6_reverse_zone_length").(int)
+
+	limit := dnsRecordsPageSize
+	params := ipam.NewIpamIPAddressesListParams().WithLimit(&limit)
+	if prefix, ok := d.GetOk("prefix"); ok {
+		parent := prefix.(string)
+		params.SetParent(&parent)
+	}
+	if tag, ok := d.GetOk("tag"); ok {
+		tagSlug := tag.(string)
+		params.SetTag(&tagSlug)
+	}
+	if vrfID, ok := d.GetOk("vrf_id"); ok {
+		vrfIDStr := strconv.Itoa(vrfID.(int))
+		params.SetVrfID(&vrfIDStr)
+	}
+
+	forwardRecords := []map[string]interface{}{}
+	reverseRecords := []map[string]interface{}{}
+
+	for read := int64(0); ; {
+		params.SetOffset(&read)
+		list, err := client.Ipam.IpamIPAddressesList(params, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, ip := range list.Payload.Results {
+			if ip.DNSName == "" {
+				continue
+			}
+
+			address, err := netip.ParsePrefix(*ip.Address)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			addr := address.Addr()
+
+			recordType := "A"
+			zoneLength := ipv4ZoneLength
+			if addr.Is6() {
+				recordType = "AAAA"
+				zoneLength = ipv6ZoneLength
+			}
+
+			forwardRecords = append(forwardRecords, map[string]interface{}{
+				"ip_address_id": ip.ID,
+				"name":          ip.DNSName,
+				"type":          recordType,
+				"value":         addr.String(),
+			})
+
+			name, zone := getReverseName(addr, zoneLength)
+			reverseRecords = append(reverseRecords, map[string]interface{}{
+				"ip_address_id": ip.ID,
+				"name":          name,
+				"reverse_zone":  zone,
+				"value":         ip.DNSName,
+			})
+		}
+
+		read += int64(len(list.Payload.Results))
+		if len(list.Payload.Results) == 0 || read >= *list.Payload.Count {
+			break
+		}
+	}
+
+	sort.SliceStable(forwardRecords, func(i, j int) bool {
+		return forwardRecords[i]["name"].(string) < forwardRecords[j]["name"].(string)
+	})
+	sort.SliceStable(reverseRecords, func(i, j int) bool {
+		return reverseRecords[i]["name"].(string) < reverseRecords[j]["name"].(string)
+	})
+
+	if err := d.Set("forward_records", forwardRecords); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("reverse_records", reverseRecords); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("NetboxIpamDNSRecords/%s/%s/%d",
+		d.Get("prefix").(string), d.Get("tag").(string), d.Get("vrf_id").(int)))
+
+	return nil
+}
+
+// getReverseName returns the name of the PTR record of addr and its reverse
+// zone, the zone covering the first zoneLength bits of addr
+func getReverseName(addr netip.Addr, zoneLength int) (string, string) {
+	var labels []string
+	var domain string
+	var bitsPerLabel int
+
+	if addr.Is4() {
+		for _, b := range addr.As4() {
+			labels = append(labels, strconv.Itoa(int(b)))
+		}
+		domain = "in-addr.arpa"
+		bitsPerLabel = 8
+	} else {
+		for _, b := range addr.As16() {
+			labels = append(labels, strconv.FormatInt(int64(b>>4), 16), strconv.FormatInt(int64(b&0xf), 16))
+		}
+		domain = "ip6.arpa"
+		bitsPerLabel = 4
+	}
+
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+
+	zoneLabels := zoneLength / bitsPerLabel
+	name := strings.Join(append(labels, domain), ".")
+	zone := strings.Join(append(labels[len(labels)-zoneLabels:], domain), ".")
+
+	return name, zone
+}
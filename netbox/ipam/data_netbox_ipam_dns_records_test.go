@@ -0,0 +1,85 @@
+package ipam
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestGetReverseName(t *testing.T) {
+	tests := []struct {
+		name       string
+		addr       string
+		zoneLength int
+		wantName   string
+		wantZone   string
+	}{
+		{
+			name:       "IPv4 /24 zone",
+			addr:       "192.0.2.10",
+			zoneLength: 24,
+			wantName:   "10.2.0.192.in-addr.arpa",
+			wantZone:   "2.0.192.in-addr.arpa",
+		},
+		{
+			name:       "IPv4 /8 zone",
+			addr:       "10.1.2.3",
+			zoneLength: 8,
+			wantName:   "3.2.1.10.in-addr.arpa",
+			wantZone:   "10.in-addr.arpa",
+		},
+		{
+			name:       "IPv4 zone not on an octet boundary",
+			addr:       "192.0.2.10",
+			zoneLength: 20,
+			wantName:   "10.2.0.192.in-addr.arpa",
+			wantZone:   "0.192.in-addr.arpa",
+		},
+		{
+			name:       "IPv4 /32 zone",
+			addr:       "192.0.2.10",
+			zoneLength: 32,
+			wantName:   "10.2.0.192.in-addr.arpa",
+			wantZone:   "10.2.0.192.in-addr.arpa",
+		},
+		{
+			name:       "IPv4 /0 zone",
+			addr:       "192.0.2.10",
+			zoneLength: 0,
+			wantName:   "10.2.0.192.in-addr.arpa",
+			wantZone:   "in-addr.arpa",
+		},
+		{
+			name:       "IPv6 /32 zone",
+			addr:       "2001:db8::1",
+			zoneLength: 32,
+			wantName:   "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+			wantZone:   "8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+		{
+			name:       "IPv6 /64 zone",
+			addr:       "2001:db8:abcd:12::ff",
+			zoneLength: 64,
+			wantName:   "f.f.0.0.0.0.0.0.0.0.0.0.0.0.0.0.2.1.0.0.d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa",
+			wantZone:   "2.1.0.0.d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+		{
+			name:       "IPv6 zone not on a nibble boundary",
+			addr:       "2001:db8:abcd:12::ff",
+			zoneLength: 18,
+			wantName:   "f.f.0.0.0.0.0.0.0.0.0.0.0.0.0.0.2.1.0.0.d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa",
+			wantZone:   "1.0.0.2.ip6.arpa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotZone := getReverseName(netip.MustParseAddr(tt.addr), tt.zoneLength)
+			if gotName != tt.wantName {
+				t.Errorf("getReverseName() name = %s, want %s", gotName, tt.wantName)
+			}
+			if gotZone != tt.wantZone {
+				t.Errorf("getReverseName() zone = %s, want %s", gotZone, tt.wantZone)
+			}
+		})
+	}
+}
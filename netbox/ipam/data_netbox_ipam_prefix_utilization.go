@@ -0,0 +1,506 @@
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+)
+
+// Number of objects requested per page when walking a list endpoint
+const utilizationPageSize int64 = 1000
+
+func DataNetboxIpamPrefixUtilization() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the utilization of a prefix or an IP range (ipam module) from netbox.",
+		ReadContext: dataNetboxIpamPrefixUtilizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"child_prefix_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of child prefixes of the prefix (always 0 for an IP range).",
+			},
+			"ip_range_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"ip_range_id", "prefix_id"},
+				Description:  "ID of the IP range (ipam module) to compute the utilization of.",
+			},
+			"largest_free_block": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The largest CIDR block of the prefix or IP range which is not used (empty when fully used).",
+			},
+			"prefix_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the prefix (ipam module) to compute the utilization of.",
+			},
+			"total_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of addresses of the prefix or IP range (as a string since IPv6 sizes do not fit in a number).",
+			},
+			"used_addresses": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of used addresses of the prefix or IP range (as a string since IPv6 sizes do not fit in a number).",
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix or IP range in percent, computed like Netbox does.",
+			},
+		},
+	}
+}
+
+// ipInterval is an inclusive interval of IP addresses
+type ipInterval struct {
+	first netip.Addr
+	last  netip.Addr
+}
+
+type prefixUtilization struct {
+	childPrefixCount int64
+	largestFreeBlock string
+	total            *big.Int
+	used             *big.Int
+	utilization      float64
+}
+
+func dataNetboxIpamPrefixUtilizationRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	var id int64
+	var usage *prefixUtilization
+	var err error
+	if prefixID := int64(d.Get("prefix_id").(int)); prefixID != 0 {
+		id = prefixID
+		usage, err = getPrefixUtilization(client, prefixID)
+	} else {
+		id = int64(d.Get("ip_range_id").(int))
+		usage, err = getIPRangeUtilization(client, id)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	if err = d.Set("child_prefix_count", usage.childPrefixCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("largest_free_block", usage.largestFreeBlock); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("total_addresses", usage.total.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("used_addresses", usage.used.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("utilization", usage.utilization); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func getPrefixUtilization(client *netboxclient.NetBoxAPI, id int64) (*prefixUtilization, error) {
+	prefixID := strconv.FormatInt(id, 10)
+	params := ipam.NewIpamPrefixesListParams().WithID(&prefixID)
+	list, err := client.Ipam.IpamPrefixesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if *list.Payload.Count != 1 {
+		return nil, fmt.Errorf("prefix %d does not exist", id)
+	}
+
+	prefix := list.Payload.Results[0]
+	network, err := netip.ParsePrefix(*prefix.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	network = network.Masked()
+	vrfID := getVrfIDFilter(prefix.Vrf)
+	isContainer := prefix.Status != nil && *prefix.Status.Value == "container"
+
+	// Like Netbox, a global container prefix contains the prefixes of all vrfs
+	childPrefixesVrfID := vrfID
+	if isContainer && prefix.Vrf == nil {
+		childPrefixesVrfID = ""
+	}
+	childPrefixes, err := listChildPrefixes(client, network, childPrefixesVrfID)
+	if err != nil {
+		return nil, err
+	}
+
+	var used []ipInterval
+	if isContainer {
+		for _, child := range childPrefixes {
+			if getVrfIDFilter(child.Vrf) == vrfID {
+				childNetwork, err := netip.ParsePrefix(*child.Prefix)
+				if err != nil {
+					return nil, err
+				}
+				used = append(used, getPrefixInterval(childNetwork.Masked()))
+			}
+		}
+	} else {
+		ranges, err := listChildIPRanges(client, network, vrfID)
+		if err != nil {
+			return nil, err
+		}
+		used = append(used, ranges...)
+
+		addresses, err := listChildIPAddresses(client, network, vrfID)
+		if err != nil {
+			return nil, err
+		}
+		used = append(used, addresses...)
+	}
+
+	usage := getPrefixUsage(network, used, isContainer, prefix.IsPool, prefix.MarkUtilized)
+	usage.childPrefixCount = int64(len(childPrefixes))
+
+	return usage, nil
+}
+
+// Compute the utilization of network from the intervals used in it
+func getPrefixUsage(network netip.Prefix, used []ipInterval, isContainer, isPool,
+	markUtilized bool) *prefixUtilization {
+	bounds := getPrefixInterval(network)
+	used = mergeIntervals(used)
+	usage := &prefixUtilization{
+		largestFreeBlock: getLargestFreeBlock(bounds, used),
+		total:            getIntervalSize(bounds),
+		used:             getIntervalsSize(used),
+	}
+
+	// The network and broadcast addresses of an IPv4 prefix can't be used
+	// unless the prefix is a pool
+	usable := new(big.Int).Set(usage.total)
+	if !isContainer && network.Addr().Is4() && network.Bits() < 31 && !isPool {
+		usable.Sub(usable, big.NewInt(2))
+	}
+	usage.utilization = getUtilizationPercent(usage.used, usable)
+
+	if markUtilized {
+		usage.largestFreeBlock = ""
+		usage.used = usage.total
+		usage.utilization = 100
+	}
+
+	return usage
+}
+
+func getIPRangeUtilization(client *netboxclient.NetBoxAPI, id int64) (*prefixUtilization, error) {
+	rangeID := strconv.FormatInt(id, 10)
+	params := ipam.NewIpamIPRangesListParams().WithID(&rangeID)
+	list, err := client.Ipam.IpamIPRangesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if *list.Payload.Count != 1 {
+		return nil, fmt.Errorf("IP range %d does not exist", id)
+	}
+
+	ipRange := list.Payload.Results[0]
+	interval, err := getIPRangeInterval(ipRange)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look for the addresses in the smallest prefix covering the range
+	covering := netip.PrefixFrom(interval.first, interval.first.BitLen())
+	for !covering.Contains(interval.last) {
+		covering, err = covering.Addr().Prefix(covering.Bits() - 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addresses, err := listChildIPAddresses(client, covering, getVrfIDFilter(ipRange.Vrf))
+	if err != nil {
+		return nil, err
+	}
+
+	var used []ipInterval
+	for _, address := range addresses {
+		if interval.first.Compare(address.first) <= 0 && address.last.Compare(interval.last) <= 0 {
+			used = append(used, address)
+		}
+	}
+	used = mergeIntervals(used)
+
+	usage := &prefixUtilization{
+		largestFreeBlock: getLargestFreeBlock(interval, used),
+		total:            getIntervalSize(interval),
+		used:             getIntervalsSize(used),
+	}
+	usage.utilization = getUtilizationPercent(usage.used, usage.total)
+
+	return usage, nil
+}
+
+// List the prefixes contained in network, in all vrfs when vrfID is empty
+func listChildPrefixes(client *netboxclient.NetBoxAPI, network netip.Prefix,
+	vrfID string) ([]*models.Prefix, error) {
+	var prefixes []*models.Prefix
+
+	within := network.String()
+	limit := utilizationPageSize
+	params := ipam.NewIpamPrefixesListParams().WithWithin(&within).WithLimit(&limit)
+	if vrfID != "" {
+		params.SetVrfID(&vrfID)
+	}
+
+	for {
+		offset := int64(len(prefixes))
+		params.SetOffset(&offset)
+		list, err := client.Ipam.IpamPrefixesList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, list.Payload.Results...)
+		if len(list.Payload.Results) == 0 || int64(len(prefixes)) >= *list.Payload.Count {
+			return prefixes, nil
+		}
+	}
+}
+
+// List the ip ranges contained in network as intervals.
+// Netbox 3.3 can't filter the ip ranges by parent prefix, so the ranges of
+// the same family are listed by start address until they go past network.
+func listChildIPRanges(client *netboxclient.NetBoxAPI, network netip.Prefix,
+	vrfID string) ([]ipInterval, error) {
+	var ranges []ipInterval
+
+	family := float64(6)
+	if network.Addr().Is4() {
+		family = 4
+	}
+	networkInterval := getPrefixInterval(network)
+	ordering := "start_address,id"
+	limit := utilizationPageSize
+	params := ipam.NewIpamIPRangesListParams().WithVrfID(&vrfID).WithFamily(&family).
+		WithOrdering(&ordering).WithLimit(&limit)
+
+	var offset int64
+	for {
+		params.SetOffset(&offset)
+		list, err := client.Ipam.IpamIPRangesList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range list.Payload.Results {
+			interval, err := getIPRangeInterval(r)
+			if err != nil {
+				return nil, err
+			}
+			if networkInterval.last.Less(interval.first) {
+				return ranges, nil
+			}
+			if network.Contains(interval.first) && network.Contains(interval.last) {
+				ranges = append(ranges, interval)
+			}
+		}
+		offset += int64(len(list.Payload.Results))
+		if len(list.Payload.Results) == 0 || offset >= *list.Payload.Count {
+			return ranges, nil
+		}
+	}
+}
+
+// List the addresses contained in network as single address intervals
+func listChildIPAddresses(client *netboxclient.NetBoxAPI, network netip.Prefix,
+	vrfID string) ([]ipInterval, error) {
+	var addresses []ipInterval
+
+	parent := network.String()
+	limit := utilizationPageSize
+	params := ipam.NewIpamIPAddressesListParams().WithParent(&parent).WithVrfID(&vrfID).WithLimit(&limit)
+
+	for {
+		offset := int64(len(addresses))
+		params.SetOffset(&offset)
+		list, err := client.Ipam.IpamIPAddressesList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range list.Payload.Results {
+			address, err := netip.ParsePrefix(*ip.Address)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, ipInterval{address.Addr(), address.Addr()})
+		}
+		if len(list.Payload.Results) == 0 || int64(len(addresses)) >= *list.Payload.Count {
+			return addresses, nil
+		}
+	}
+}
+
+func getIPRangeInterval(ipRange *models.IPRange) (ipInterval, error) {
+	first, err := netip.ParsePrefix(*ipRange.StartAddress)
+	if err != nil {
+		return ipInterval{}, err
+	}
+	last, err := netip.ParsePrefix(*ipRange.EndAddress)
+	if err != nil {
+		return ipInterval{}, err
+	}
+	return ipInterval{first.Addr(), last.Addr()}, nil
+}
+
+func getPrefixInterval(network netip.Prefix) ipInterval {
+	last := getAddrInt(network.Addr())
+	hostBits := uint(network.Addr().BitLen() - network.Bits())
+	last.Add(last, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), hostBits), big.NewInt(1)))
+	return ipInterval{network.Addr(), getIntAddr(last, network.Addr().Is4())}
+}
+
+// Sort the intervals and merge the ones which overlap or are adjacent
+func mergeIntervals(intervals []ipInterval) []ipInterval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].first.Less(intervals[j].first)
+	})
+
+	var merged []ipInterval
+	for _, interval := range intervals {
+		if n := len(merged); n > 0 && (!merged[n-1].last.Next().IsValid() ||
+			interval.first.Compare(merged[n-1].last.Next()) <= 0) {
+			if merged[n-1].last.Less(interval.last) {
+				merged[n-1].last = interval.last
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
+
+func getIntervalSize(interval ipInterval) *big.Int {
+	size := new(big.Int).Sub(getAddrInt(interval.last), getAddrInt(interval.first))
+	return size.Add(size, big.NewInt(1))
+}
+
+func getIntervalsSize(intervals []ipInterval) *big.Int {
+	size := big.NewInt(0)
+	for _, interval := range intervals {
+		size.Add(size, getIntervalSize(interval))
+	}
+	return size
+}
+
+func getUtilizationPercent(used, total *big.Int) float64 {
+	if total.Sign() <= 0 {
+		return 100
+	}
+
+	percent, _ := new(big.Float).Quo(new(big.Float).SetInt(used), new(big.Float).SetInt(total)).Float64()
+	percent *= 100
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// Return the largest CIDR block of bounds which is not covered by the merged
+// intervals used
+func getLargestFreeBlock(bounds ipInterval, used []ipInterval) string {
+	var best netip.Prefix
+
+	start := getAddrInt(bounds.first)
+	end := getAddrInt(bounds.last)
+	isIPv4 := bounds.first.Is4()
+	one := big.NewInt(1)
+
+	checkGap := func(first, last *big.Int) {
+		if block := getLargestCIDR(first, last, isIPv4); block.IsValid() &&
+			(!best.IsValid() || block.Bits() < best.Bits()) {
+			best = block
+		}
+	}
+
+	for _, interval := range used {
+		first := getAddrInt(interval.first)
+		if first.Cmp(start) > 0 {
+			checkGap(start, new(big.Int).Sub(first, one))
+		}
+		start = new(big.Int).Add(getAddrInt(interval.last), one)
+	}
+	if start.Cmp(end) <= 0 {
+		checkGap(start, end)
+	}
+
+	if !best.IsValid() {
+		return ""
+	}
+	return best.String()
+}
+
+// Return the largest CIDR block contained in the inclusive range first-last
+func getLargestCIDR(first, last *big.Int, isIPv4 bool) netip.Prefix {
+	bitLen := 128
+	if isIPv4 {
+		bitLen = 32
+	}
+
+	var best netip.Prefix
+	current := new(big.Int).Set(first)
+	for current.Cmp(last) <= 0 {
+		hostBits := int(current.TrailingZeroBits())
+		if current.Sign() == 0 || hostBits > bitLen {
+			hostBits = bitLen
+		}
+
+		var blockLast *big.Int
+		for {
+			blockLast = new(big.Int).Lsh(big.NewInt(1), uint(hostBits))
+			blockLast.Add(blockLast, current)
+			blockLast.Sub(blockLast, big.NewInt(1))
+			if blockLast.Cmp(last) <= 0 {
+				break
+			}
+			hostBits--
+		}
+
+		if !best.IsValid() || bitLen-hostBits < best.Bits() {
+			best = netip.PrefixFrom(getIntAddr(current, isIPv4), bitLen-hostBits)
+		}
+		current = blockLast.Add(blockLast, big.NewInt(1))
+	}
+
+	return best
+}
+
+func getAddrInt(addr netip.Addr) *big.Int {
+	b := addr.AsSlice()
+	return new(big.Int).SetBytes(b)
+}
+
+func getIntAddr(i *big.Int, isIPv4 bool) netip.Addr {
+	if isIPv4 {
+		var b [4]byte
+		i.FillBytes(b[:])
+		return netip.AddrFrom4(b)
+	}
+	var b [16]byte
+	i.FillBytes(b[:])
+	return netip.AddrFrom16(b)
+}
@@ -0,0 +1,260 @@
+package ipam
+
+import (
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func testInterval(first, last string) ipInterval {
+	return ipInterval{netip.MustParseAddr(first), netip.MustParseAddr(last)}
+}
+
+func TestMergeIntervals(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []ipInterval
+		want      []ipInterval
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "disjoint intervals are sorted",
+			intervals: []ipInterval{
+				testInterval("10.0.0.5", "10.0.0.6"),
+				testInterval("10.0.0.1", "10.0.0.2"),
+			},
+			want: []ipInterval{
+				testInterval("10.0.0.1", "10.0.0.2"),
+				testInterval("10.0.0.5", "10.0.0.6"),
+			},
+		},
+		{
+			name: "overlapping intervals",
+			intervals: []ipInterval{
+				testInterval("10.0.0.3", "10.0.0.8"),
+				testInterval("10.0.0.1", "10.0.0.5"),
+			},
+			want: []ipInterval{testInterval("10.0.0.1", "10.0.0.8")},
+		},
+		{
+			name: "adjacent intervals",
+			intervals: []ipInterval{
+				testInterval("10.0.0.1", "10.0.0.2"),
+				testInterval("10.0.0.3", "10.0.0.4"),
+			},
+			want: []ipInterval{testInterval("10.0.0.1", "10.0.0.4")},
+		},
+		{
+			name: "contained interval",
+			intervals: []ipInterval{
+				testInterval("10.0.0.1", "10.0.0.10"),
+				testInterval("10.0.0.3", "10.0.0.4"),
+			},
+			want: []ipInterval{testInterval("10.0.0.1", "10.0.0.10")},
+		},
+		{
+			name: "end of the IPv4 address space",
+			intervals: []ipInterval{
+				testInterval("255.255.255.0", "255.255.255.255"),
+				testInterval("255.255.255.255", "255.255.255.255"),
+			},
+			want: []ipInterval{testInterval("255.255.255.0", "255.255.255.255")},
+		},
+		{
+			name: "adjacent IPv6 intervals",
+			intervals: []ipInterval{
+				testInterval("2001:db8::100", "2001:db8::1ff"),
+				testInterval("2001:db8::1", "2001:db8::ff"),
+				testInterval("2001:db8::1:0", "2001:db8::1:0"),
+			},
+			want: []ipInterval{
+				testInterval("2001:db8::1", "2001:db8::1ff"),
+				testInterval("2001:db8::1:0", "2001:db8::1:0"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeIntervals(tt.intervals); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeIntervals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLargestCIDR(t *testing.T) {
+	tests := []struct {
+		name  string
+		first string
+		last  string
+		want  string
+	}{
+		{"aligned block", "10.0.0.0", "10.0.0.255", "10.0.0.0/24"},
+		{"unaligned bounds", "10.0.0.1", "10.0.0.6", "10.0.0.2/31"},
+		{"single address", "10.0.0.7", "10.0.0.7", "10.0.0.7/32"},
+		{"whole IPv4 address space", "0.0.0.0", "255.255.255.255", "0.0.0.0/0"},
+		{"IPv6 block", "2001:db8::", "2001:db8::ffff", "2001:db8::/112"},
+		{"unaligned IPv6 bounds", "2001:db8::1", "2001:db8::1:0", "2001:db8::8000/113"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := netip.MustParseAddr(tt.first)
+			got := getLargestCIDR(getAddrInt(first), getAddrInt(netip.MustParseAddr(tt.last)), first.Is4())
+			if got.String() != tt.want {
+				t.Errorf("getLargestCIDR() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLargestFreeBlock(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds ipInterval
+		used   []ipInterval
+		want   string
+	}{
+		{
+			name:   "empty prefix",
+			bounds: getPrefixInterval(netip.MustParsePrefix("10.0.0.0/24")),
+			want:   "10.0.0.0/24",
+		},
+		{
+			name:   "full prefix",
+			bounds: getPrefixInterval(netip.MustParsePrefix("10.0.0.0/24")),
+			used:   []ipInterval{testInterval("10.0.0.0", "10.0.0.255")},
+			want:   "",
+		},
+		{
+			name:   "first half used",
+			bounds: getPrefixInterval(netip.MustParsePrefix("10.0.0.0/24")),
+			used:   []ipInterval{testInterval("10.0.0.0", "10.0.0.127")},
+			want:   "10.0.0.128/25",
+		},
+		{
+			name:   "gaps before and after the used interval",
+			bounds: getPrefixInterval(netip.MustParsePrefix("10.0.0.0/24")),
+			used:   []ipInterval{testInterval("10.0.0.1", "10.0.0.1")},
+			want:   "10.0.0.128/25",
+		},
+		{
+			name:   "used interval at the end of the IPv4 address space",
+			bounds: getPrefixInterval(netip.MustParsePrefix("255.255.255.0/24")),
+			used:   []ipInterval{testInterval("255.255.255.128", "255.255.255.255")},
+			want:   "255.255.255.0/25",
+		},
+		{
+			name:   "IPv6 prefix",
+			bounds: getPrefixInterval(netip.MustParsePrefix("2001:db8::/64")),
+			used:   []ipInterval{getPrefixInterval(netip.MustParsePrefix("2001:db8::/65"))},
+			want:   "2001:db8:0:0:8000::/65",
+		},
+		{
+			name:   "IP range",
+			bounds: testInterval("10.0.0.10", "10.0.0.20"),
+			used:   []ipInterval{testInterval("10.0.0.12", "10.0.0.15")},
+			want:   "10.0.0.16/30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getLargestFreeBlock(tt.bounds, tt.used); got != tt.want {
+				t.Errorf("getLargestFreeBlock() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPrefixUsage(t *testing.T) {
+	tests := []struct {
+		name         string
+		network      string
+		used         []ipInterval
+		isContainer  bool
+		isPool       bool
+		markUtilized bool
+		want         prefixUtilization
+	}{
+		{
+			name:    "empty IPv4 prefix",
+			network: "10.0.0.0/24",
+			want:    prefixUtilization{largestFreeBlock: "10.0.0.0/24", total: big.NewInt(256), used: big.NewInt(0)},
+		},
+		{
+			name:    "IPv4 prefix without the network and broadcast addresses",
+			network: "10.0.0.0/24",
+			used:    []ipInterval{testInterval("10.0.0.1", "10.0.0.254")},
+			want: prefixUtilization{largestFreeBlock: "10.0.0.0/32", total: big.NewInt(256),
+				used: big.NewInt(254), utilization: 100},
+		},
+		{
+			name:    "IPv4 pool",
+			network: "10.0.0.0/24",
+			used:    []ipInterval{testInterval("10.0.0.1", "10.0.0.254")},
+			isPool:  true,
+			want: prefixUtilization{largestFreeBlock: "10.0.0.0/32", total: big.NewInt(256),
+				used: big.NewInt(254), utilization: 99.21875},
+		},
+		{
+			name:        "IPv4 container",
+			network:     "10.0.0.0/24",
+			used:        []ipInterval{getPrefixInterval(netip.MustParsePrefix("10.0.0.0/25"))},
+			isContainer: true,
+			want: prefixUtilization{largestFreeBlock: "10.0.0.128/25", total: big.NewInt(256),
+				used: big.NewInt(128), utilization: 50},
+		},
+		{
+			name:    "IPv4 point to point prefix",
+			network: "10.0.0.0/31",
+			used:    []ipInterval{testInterval("10.0.0.0", "10.0.0.0")},
+			want: prefixUtilization{largestFreeBlock: "10.0.0.1/32", total: big.NewInt(2),
+				used: big.NewInt(1), utilization: 50},
+		},
+		{
+			name:    "IPv6 prefix",
+			network: "2001:db8::/126",
+			used:    []ipInterval{testInterval("2001:db8::", "2001:db8::")},
+			want: prefixUtilization{largestFreeBlock: "2001:db8::2/127", total: big.NewInt(4),
+				used: big.NewInt(1), utilization: 25},
+		},
+		{
+			name:         "prefix marked utilized",
+			network:      "10.0.0.0/24",
+			used:         []ipInterval{testInterval("10.0.0.1", "10.0.0.1")},
+			markUtilized: true,
+			want:         prefixUtilization{total: big.NewInt(256), used: big.NewInt(256), utilization: 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getPrefixUsage(netip.MustParsePrefix(tt.network), tt.used, tt.isContainer, tt.isPool,
+				tt.markUtilized)
+			if got.largestFreeBlock != tt.want.largestFreeBlock {
+				t.Errorf("largestFreeBlock = %q, want %q", got.largestFreeBlock, tt.want.largestFreeBlock)
+			}
+			if got.total.Cmp(tt.want.total) != 0 {
+				t.Errorf("total = %s, want %s", got.total, tt.want.total)
+			}
+			if got.used.Cmp(tt.want.used) != 0 {
+				t.Errorf("used = %s, want %s", got.used, tt.want.used)
+			}
+			if got.utilization != tt.want.utilization {
+				t.Errorf("utilization = %v, want %v", got.utilization, tt.want.utilization)
+			}
+		})
+	}
+}
+
+func TestGetPrefixUsageIPv6Size(t *testing.T) {
+	got := getPrefixUsage(netip.MustParsePrefix("2001:db8::/64"), nil, false, false, false)
+	if want := "18446744073709551616"; got.total.String() != want {
+		t.Errorf("total = %s, want %s", got.total, want)
+	}
+}
@@ -0,0 +1,63 @@
+package ipam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func DataNetboxIpamRouteTarget() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get info about route target (ipam module) from netbox.",
+		ReadContext: dataNetboxIpamRouteTargetRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this route target (ipam module).",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 21),
+				Description:  "The name of the route target (ipam module).",
+			},
+		},
+	}
+}
+
+func dataNetboxIpamRouteTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	name := d.Get("name").(string)
+
+	p := ipam.NewIpamRouteTargetsListParams().WithName(&name)
+
+	list, err := client.Ipam.IpamRouteTargetsList(p, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if *list.Payload.Count < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	} else if *list.Payload.Count > 1 {
+		return diag.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	r := list.Payload.Results[0]
+	d.SetId(strconv.FormatInt(r.ID, 10))
+	if err = d.Set("content_type", util.ConvertURIContentType(r.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
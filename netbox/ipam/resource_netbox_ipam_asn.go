@@ -2,12 +2,16 @@ package ipam
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/circuits"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
 	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
 	"github.com/smutel/go-netbox/v3/netbox/models"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
@@ -24,16 +28,42 @@ func ResourceNetboxIpamASN() *schema.Resource {
 		UpdateContext: resourceNetboxIpamASNUpdate,
 		DeleteContext: resourceNetboxIpamASNDelete,
 		// Exists:        resourceNetboxIpamASNExists,
+		CustomizeDiff: resourceNetboxIpamASNCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allocation_range": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"allocation_range", "asn"},
+				Description:  "The range where the asn number of this asn (ipam module) is allocated, the lowest free number is used when the asn is created. The asn is recreated when the range is changed and its number is not in the new range.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 4294967295),
+							Description:  "The last asn number of the range.",
+						},
+						"start": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 4294967295),
+							Description:  "The first asn number of the range.",
+						},
+					},
+				},
+			},
 			"asn": {
 				Type:         schema.TypeInt,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"allocation_range", "asn"},
 				ValidateFunc: validation.IntAtLeast(1),
-				Description:  "The asn number of this asn (ipam module).",
+				Description:  "The asn number of this asn (ipam module). Required if allocation_range is not set.",
 			},
 			"content_type": {
 				Type:        schema.TypeString,
@@ -63,6 +93,15 @@ func ResourceNetboxIpamASN() *schema.Resource {
 				Computed:    true,
 				Description: "The number of providers for this asn (ipam module).",
 			},
+			"provider_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the circuit providers referencing this asn (ipam module).",
+			},
 			"rir_id": {
 				Type:        schema.TypeInt,
 				Required:    true,
@@ -73,6 +112,15 @@ func ResourceNetboxIpamASN() *schema.Resource {
 				Computed:    true,
 				Description: "The number of sites for this asn (ipam module).",
 			},
+			"site_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the sites referencing this asn (ipam module). Don't manage the same asn with the asns of netbox_dcim_site.",
+			},
 			"tag": &tag.TagSchema,
 			"tenant_id": {
 				Type:        schema.TypeInt,
@@ -88,6 +136,13 @@ func ResourceNetboxIpamASN() *schema.Resource {
 	}
 }
 
+// Serializes the allocations of asn numbers, Terraform creates the resources
+// in parallel
+var asnAllocationMutex sync.Mutex
+
+// Number of asns read per request when looking for a free asn number
+const asnPageSize int64 = 1000
+
 var asnRequiredFields = []string{
 	"created",
 	"last_updated",
@@ -96,6 +151,44 @@ var asnRequiredFields = []string{
 	"tag",
 }
 
+// resourceNetboxIpamASNCustomizeDiff checks the allocation range at plan time
+// and recreates the asn when its number is not in the new allocation range
+func resourceNetboxIpamASNCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	allocationRange := d.Get("allocation_range").([]interface{})
+	if len(allocationRange) != 1 || allocationRange[0] == nil {
+		return nil
+	}
+	if !d.NewValueKnown("allocation_range.0.start") || !d.NewValueKnown("allocation_range.0.end") {
+		return nil
+	}
+
+	start := int64(allocationRange[0].(map[string]interface{})["start"].(int))
+	end := int64(allocationRange[0].(map[string]interface{})["end"].(int))
+	if start > end {
+		return fmt.Errorf("the start (%d) of the allocation range is greater than its end (%d)",
+			start, end)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if asn := int64(d.Get("asn").(int)); asn >= start && asn <= end {
+		return nil
+	}
+
+	for _, key := range []string{"allocation_range.0.start", "allocation_range.0.end"} {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return d.SetNewComputed("asn")
+}
+
 func resourceNetboxIpamASNCreate(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
@@ -105,6 +198,20 @@ func resourceNetboxIpamASNCreate(ctx context.Context, d *schema.ResourceData,
 
 	asn := int64(d.Get("asn").(int))
 	rirID := int64(d.Get("rir_id").(int))
+
+	if allocationRange := d.Get("allocation_range").([]interface{}); len(allocationRange) == 1 {
+		asnAllocationMutex.Lock()
+		defer asnAllocationMutex.Unlock()
+
+		start := int64(allocationRange[0].(map[string]interface{})["start"].(int))
+		end := int64(allocationRange[0].(map[string]interface{})["end"].(int))
+
+		var err error
+		asn, err = getNextAvailableASN(client, start, end)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	tags := d.Get("tag").(*schema.Set).List()
 
 	newResource := &models.WritableASN{
@@ -128,6 +235,20 @@ func resourceNetboxIpamASNCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
 
+	if providerIDs := d.Get("provider_ids").(*schema.Set).List(); len(providerIDs) > 0 {
+		err = updateASNProviders(client, resourceCreated.Payload.ID, nil, providerIDs)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if siteIDs := d.Get("site_ids").(*schema.Set).List(); len(siteIDs) > 0 {
+		err = updateASNSites(client, resourceCreated.Payload.ID, nil, siteIDs)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetboxIpamASNRead(ctx, d, m)
 }
 
@@ -174,12 +295,30 @@ func resourceNetboxIpamASNRead(ctx context.Context, d *schema.ResourceData,
 	if err = d.Set("provider_count", resource.ProviderCount); err != nil {
 		return diag.FromErr(err)
 	}
+
+	providerIDs, err := getASNProviderIDs(client, resource.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("provider_ids", providerIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = d.Set("rir_id", resource.Rir); err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("site_count", resource.SiteCount); err != nil {
 		return diag.FromErr(err)
 	}
+
+	siteIDs, err := getASNSiteIDs(client, resource.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("site_ids", siteIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -243,6 +382,24 @@ func resourceNetboxIpamASNUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("provider_ids") {
+		oldProviderIDs, newProviderIDs := d.GetChange("provider_ids")
+		err = updateASNProviders(client, resourceID, oldProviderIDs.(*schema.Set).List(),
+			newProviderIDs.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("site_ids") {
+		oldSiteIDs, newSiteIDs := d.GetChange("site_ids")
+		err = updateASNSites(client, resourceID, oldSiteIDs.(*schema.Set).List(),
+			newSiteIDs.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetboxIpamASNRead(ctx, d, m)
 }
 
@@ -292,3 +449,201 @@ func resourceNetboxIpamASNExists(d *schema.ResourceData,
 
 	return resourceExist, nil
 }
+
+// getNextAvailableASN returns the lowest asn number between start and end
+// which is not used in Netbox yet
+func getNextAvailableASN(client *netboxclient.NetBoxAPI, start, end int64) (int64, error) {
+	if start > end {
+		return 0, fmt.Errorf("the start (%d) of the allocation range is greater than its end (%d)",
+			start, end)
+	}
+
+	asnGte := strconv.FormatInt(start, 10)
+	asnLte := strconv.FormatInt(end, 10)
+	ordering := "asn"
+	limit := asnPageSize
+	params := ipam.NewIpamAsnsListParams().WithAsnGte(&asnGte).WithAsnLte(
+		&asnLte).WithOrdering(&ordering).WithLimit(&limit)
+
+	next := start
+	for read := int64(0); ; {
+		params.SetOffset(&read)
+		list, err := client.Ipam.IpamAsnsList(params, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, asn := range list.Payload.Results {
+			if *asn.Asn > next {
+				return next, nil
+			}
+			next = *asn.Asn + 1
+		}
+
+		read += int64(len(list.Payload.Results))
+		if len(list.Payload.Results) == 0 || read >= *list.Payload.Count {
+			break
+		}
+	}
+
+	if next > end {
+		return 0, fmt.Errorf("no asn available between %d and %d", start, end)
+	}
+
+	return next, nil
+}
+
+// getASNSiteIDs returns the IDs of the sites referencing the asn asnID
+func getASNSiteIDs(client *netboxclient.NetBoxAPI, asnID int64) ([]int64, error) {
+	siteIDs := []int64{}
+
+	asnIDStr := strconv.FormatInt(asnID, 10)
+	limit := asnPageSize
+	params := dcim.NewDcimSitesListParams().WithAsnID(&asnIDStr).WithLimit(&limit)
+
+	for {
+		offset := int64(len(siteIDs))
+		params.SetOffset(&offset)
+		list, err := client.Dcim.DcimSitesList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, site := range list.Payload.Results {
+			siteIDs = append(siteIDs, site.ID)
+		}
+		if len(list.Payload.Results) == 0 || int64(len(siteIDs)) >= *list.Payload.Count {
+			return siteIDs, nil
+		}
+	}
+}
+
+// updateASNSites adds the asn asnID to the asns of the sites which are in
+// newSiteIDs only and removes it from the sites which are in oldSiteIDs only
+func updateASNSites(client *netboxclient.NetBoxAPI, asnID int64, oldSiteIDs,
+	newSiteIDs []interface{}) error {
+	sites := getASNReferenceChanges(oldSiteIDs, newSiteIDs)
+
+	dropFields := []string{
+		"created",
+		"last_updated",
+		"name",
+		"slug",
+		"tags",
+	}
+
+	for siteID, add := range sites {
+		site, err := client.Dcim.DcimSitesRead(dcim.NewDcimSitesReadParams().WithID(siteID), nil)
+		if err != nil {
+			return err
+		}
+
+		asns := []int64{}
+		for _, asn := range site.Payload.Asns {
+			if asn.ID != asnID {
+				asns = append(asns, asn.ID)
+			}
+		}
+		if add {
+			asns = append(asns, asnID)
+		}
+
+		params := dcim.NewDcimSitesPartialUpdateParams().WithData(
+			&models.WritableSite{Asns: asns})
+		params.SetID(siteID)
+		_, err = client.Dcim.DcimSitesPartialUpdate(params, nil,
+			requestmodifier.NewRequestModifierOperation(map[string]interface{}{}, dropFields))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getASNProviderIDs returns the IDs of the circuit providers referencing the
+// asn asnID
+func getASNProviderIDs(client *netboxclient.NetBoxAPI, asnID int64) ([]int64, error) {
+	providerIDs := []int64{}
+
+	asnIDStr := strconv.FormatInt(asnID, 10)
+	limit := asnPageSize
+	params := circuits.NewCircuitsProvidersListParams().WithAsnID(&asnIDStr).WithLimit(&limit)
+
+	for {
+		offset := int64(len(providerIDs))
+		params.SetOffset(&offset)
+		list, err := client.Circuits.CircuitsProvidersList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, provider := range list.Payload.Results {
+			providerIDs = append(providerIDs, provider.ID)
+		}
+		if len(list.Payload.Results) == 0 || int64(len(providerIDs)) >= *list.Payload.Count {
+			return providerIDs, nil
+		}
+	}
+}
+
+// updateASNProviders adds the asn asnID to the asns of the circuit providers
+// which are in newProviderIDs only and removes it from the circuit providers
+// which are in oldProviderIDs only
+func updateASNProviders(client *netboxclient.NetBoxAPI, asnID int64, oldProviderIDs,
+	newProviderIDs []interface{}) error {
+	providers := getASNReferenceChanges(oldProviderIDs, newProviderIDs)
+
+	dropFields := []string{
+		"created",
+		"last_updated",
+		"name",
+		"slug",
+		"tags",
+	}
+
+	for providerID, add := range providers {
+		provider, err := client.Circuits.CircuitsProvidersRead(
+			circuits.NewCircuitsProvidersReadParams().WithID(providerID), nil)
+		if err != nil {
+			return err
+		}
+
+		asns := []int64{}
+		for _, asn := range provider.Payload.Asns {
+			if asn.ID != asnID {
+				asns = append(asns, asn.ID)
+			}
+		}
+		if add {
+			asns = append(asns, asnID)
+		}
+
+		params := circuits.NewCircuitsProvidersPartialUpdateParams().WithData(
+			&models.WritableProvider{Asns: asns})
+		params.SetID(providerID)
+		_, err = client.Circuits.CircuitsProvidersPartialUpdate(params, nil,
+			requestmodifier.NewRequestModifierOperation(map[string]interface{}{}, dropFields))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getASNReferenceChanges returns the IDs which are only in oldIDs (mapped to
+// false) or only in newIDs (mapped to true)
+func getASNReferenceChanges(oldIDs, newIDs []interface{}) map[int64]bool {
+	changes := map[int64]bool{}
+	for _, id := range oldIDs {
+		changes[int64(id.(int))] = false
+	}
+	for _, id := range newIDs {
+		if _, ok := changes[int64(id.(int))]; ok {
+			delete(changes, int64(id.(int)))
+		} else {
+			changes[int64(id.(int))] = true
+		}
+	}
+
+	return changes
+}
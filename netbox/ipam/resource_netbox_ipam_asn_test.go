@@ -1,6 +1,7 @@
 package ipam_test
 
 import (
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -93,6 +94,82 @@ func TestAccNetboxIpamAsnMinimalFullMinimal(t *testing.T) {
 	})
 }
 
+func TestAccNetboxIpamAsnAllocationRange(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	start := int64(acctest.RandIntRange(4200000000, 4294967000))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamAsnAllocationConfig(nameSuffix, start, start+9),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameNetboxIpamAsn),
+					resource.TestCheckResourceAttr(resourceNameNetboxIpamAsn, "asn", strconv.FormatInt(start, 10)),
+					resource.TestCheckResourceAttr("netbox_ipam_asn.test2", "asn", strconv.FormatInt(start+1, 10)),
+				),
+			},
+			{
+				// The asns are still in the range, they are kept
+				Config: testAccCheckNetboxIpamAsnAllocationConfig(nameSuffix, start, start+19),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameNetboxIpamAsn, "asn", strconv.FormatInt(start, 10)),
+					resource.TestCheckResourceAttr("netbox_ipam_asn.test2", "asn", strconv.FormatInt(start+1, 10)),
+				),
+			},
+			{
+				// The asns are not in the range anymore, they are recreated
+				Config: testAccCheckNetboxIpamAsnAllocationConfig(nameSuffix, start+10, start+19),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameNetboxIpamAsn, "asn", strconv.FormatInt(start+10, 10)),
+					resource.TestCheckResourceAttr("netbox_ipam_asn.test2", "asn", strconv.FormatInt(start+11, 10)),
+				),
+			},
+			{
+				Config:      testAccCheckNetboxIpamAsnAllocationConfig(nameSuffix, start+19, start+10),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is greater than its end"),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamAsnAllocationConfig(nameSuffix string, start, end int64) string {
+	template := `
+	resource "netbox_ipam_rir" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_asn" "test" {
+		rir_id = netbox_ipam_rir.test.id
+
+		allocation_range {
+			start = {{ .start }}
+			end   = {{ .end }}
+		}
+	}
+
+	resource "netbox_ipam_asn" "test2" {
+		rir_id = netbox_ipam_rir.test.id
+
+		allocation_range {
+			start = {{ .start }}
+			end   = {{ .end }}
+		}
+
+		depends_on = [netbox_ipam_asn.test]
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+		"start":      strconv.FormatInt(start, 10),
+		"end":        strconv.FormatInt(end, 10),
+	}
+	return util.RenderTemplate(template, data)
+}
+
 func testAccCheckNetboxIpamAsnConfig(nameSuffix string, resourceFull, extraResources bool, asn int64) string {
 	template := `
 	resource "netbox_ipam_rir" "test" {
@@ -110,6 +187,11 @@ func testAccCheckNetboxIpamAsnConfig(nameSuffix string, resourceFull, extraResou
 		name = "test-{{ .namesuffix }}"
 		slug = "test-{{ .namesuffix }}"
 	}
+
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
 	{{ end }}
 
 	resource "netbox_ipam_asn" "test" {
@@ -118,6 +200,7 @@ func testAccCheckNetboxIpamAsnConfig(nameSuffix string, resourceFull, extraResou
 		{{ if eq .resourcefull "true" }}
 		description = "Test ASN"
 		tenant_id   = netbox_tenancy_tenant.test.id
+		site_ids    = [netbox_dcim_site.test.id]
 
 		tag {
 			name = netbox_extras_tag.test.name
@@ -0,0 +1,494 @@
+package ipam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxIpamFhrpGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a FHRP group (ipam module) within Netbox.",
+		CreateContext: resourceNetboxIpamFhrpGroupCreate,
+		ReadContext:   resourceNetboxIpamFhrpGroupRead,
+		UpdateContext: resourceNetboxIpamFhrpGroupUpdate,
+		DeleteContext: resourceNetboxIpamFhrpGroupDelete,
+		Exists:        resourceNetboxIpamFhrpGroupExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auth_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  "The authentication key of this FHRP group (ipam module).",
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"plaintext", "md5"}, false),
+				Description:  "The authentication type of this FHRP group (ipam module) among plaintext or md5.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this FHRP group (ipam module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this FHRP group was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nil,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+				Description:  "The description of this FHRP group (ipam module).",
+			},
+			"group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "The group ID (VRID, HSRP group number, ...) of this FHRP group (ipam module).",
+			},
+			"ip_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Virtual IP address created and assigned to this FHRP group (ipam module).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+							Description:  "The virtual IP address (with mask).",
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the virtual IP address.",
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{"vip", "vrrp",
+								"hsrp", "glbp", "carp"}, false),
+							Description: "The role of the virtual IP address among vip, vrrp, hsrp, glbp, carp (derived from the protocol by default).",
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "active",
+							ValidateFunc: validation.StringInSlice([]string{"active",
+								"reserved", "deprecated", "dhcp", "slaac"}, false),
+							Description: "The status of the virtual IP address among active, reserved, deprecated, dhcp, slaac (active by default).",
+						},
+					},
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of all the IP addresses assigned to this FHRP group (ipam module).",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this FHRP group was last updated.",
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{"vrrp2", "vrrp3",
+					"carp", "clusterxl", "hsrp", "glbp", "other"}, false),
+				Description: "The protocol of this FHRP group (ipam module) among vrrp2, vrrp3, carp, clusterxl, hsrp, glbp, other.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this FHRP group (ipam module).",
+			},
+		},
+	}
+}
+
+var fhrpGroupRequiredFields = []string{
+	"created",
+	"last_updated",
+	"group_id",
+	"ip_addresses",
+	"protocol",
+	"tags",
+}
+
+// Default role of the virtual IP address for each FHRP protocol
+var fhrpGroupProtocolRoles = map[string]string{
+	"vrrp2": "vrrp",
+	"vrrp3": "vrrp",
+	"hsrp":  "hsrp",
+	"glbp":  "glbp",
+	"carp":  "carp",
+}
+
+func resourceNetboxIpamFhrpGroupCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	groupID := int64(d.Get("group_id").(int))
+	protocol := d.Get("protocol").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.FHRPGroup{
+		AuthKey:      d.Get("auth_key").(string),
+		AuthType:     d.Get("auth_type").(string),
+		CustomFields: &customFields,
+		Description:  d.Get("description").(string),
+		GroupID:      &groupID,
+		Protocol:     &protocol,
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+
+	resource := ipam.NewIpamFhrpGroupsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Ipam.IpamFhrpGroupsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	if ipAddress := d.Get("ip_address").([]interface{}); len(ipAddress) == 1 {
+		vip := ipAddress[0].(map[string]interface{})
+		ipID, err := createFhrpGroupIPAddress(client, resourceCreated.Payload.ID, protocol, vip)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		vip["id"] = ipID
+		if err = d.Set("ip_address", []interface{}{vip}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxIpamFhrpGroupRead(ctx, d, m)
+}
+
+func resourceNetboxIpamFhrpGroupRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := ipam.NewIpamFhrpGroupsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamFhrpGroupsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("auth_key", resource.AuthKey); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_type", resource.AuthType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group_id", resource.GroupID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ipAddresses []int64
+	for _, ip := range resource.IPAddresses {
+		ipAddresses = append(ipAddresses, ip.ID)
+	}
+	if err = d.Set("ip_addresses", ipAddresses); err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipAddress, err := readFhrpGroupIPAddress(client, d.Get("ip_address").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ip_address", ipAddress); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("protocol", resource.Protocol); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamFhrpGroupUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.FHRPGroup{}
+
+	if d.HasChange("auth_key") {
+		params.AuthKey = d.Get("auth_key").(string)
+		modifiedFields["auth_key"] = params.AuthKey
+	}
+	if d.HasChange("auth_type") {
+		params.AuthType = d.Get("auth_type").(string)
+		modifiedFields["auth_type"] = params.AuthType
+	}
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		params.Description = d.Get("description").(string)
+		modifiedFields["description"] = params.Description
+	}
+	if d.HasChange("group_id") {
+		groupID := int64(d.Get("group_id").(int))
+		params.GroupID = &groupID
+	}
+	if d.HasChange("protocol") {
+		protocol := d.Get("protocol").(string)
+		params.Protocol = &protocol
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := ipam.NewIpamFhrpGroupsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Ipam.IpamFhrpGroupsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, fhrpGroupRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("ip_address") {
+		oldIPAddress, newIPAddress := d.GetChange("ip_address")
+		oldVIP := oldIPAddress.([]interface{})
+		newVIP := newIPAddress.([]interface{})
+		protocol := d.Get("protocol").(string)
+
+		var oldIPID int64
+		if len(oldVIP) == 1 {
+			oldIPID = int64(oldVIP[0].(map[string]interface{})["id"].(int))
+		}
+
+		switch {
+		case len(newVIP) == 0 && oldIPID != 0:
+			params := ipam.NewIpamIPAddressesDeleteParams().WithID(oldIPID)
+			if _, err := client.Ipam.IpamIPAddressesDelete(params, nil); err != nil {
+				return diag.FromErr(err)
+			}
+		case len(newVIP) == 1 && oldIPID == 0:
+			vip := newVIP[0].(map[string]interface{})
+			ipID, err := createFhrpGroupIPAddress(client, resourceID, protocol, vip)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			vip["id"] = ipID
+			if err = d.Set("ip_address", []interface{}{vip}); err != nil {
+				return diag.FromErr(err)
+			}
+		case len(newVIP) == 1:
+			vip := newVIP[0].(map[string]interface{})
+			if err := updateFhrpGroupIPAddress(client, oldIPID, protocol, vip); err != nil {
+				return diag.FromErr(err)
+			}
+			vip["id"] = int(oldIPID)
+			if err = d.Set("ip_address", []interface{}{vip}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceNetboxIpamFhrpGroupRead(ctx, d, m)
+}
+
+func resourceNetboxIpamFhrpGroupDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxIpamFhrpGroupExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := ipam.NewIpamFhrpGroupsDeleteParams().WithID(id)
+	if _, err := client.Ipam.IpamFhrpGroupsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamFhrpGroupExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := ipam.NewIpamFhrpGroupsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamFhrpGroupsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
+
+func getFhrpGroupIPAddressRole(protocol string, vip map[string]interface{}) string {
+	if role := vip["role"].(string); role != "" {
+		return role
+	}
+
+	if role, ok := fhrpGroupProtocolRoles[protocol]; ok {
+		return role
+	}
+
+	return "vip"
+}
+
+func createFhrpGroupIPAddress(client *netboxclient.NetBoxAPI, groupID int64,
+	protocol string, vip map[string]interface{}) (int, error) {
+	address := vip["address"].(string)
+	objectType := fhrpGroupType
+
+	newIP := &models.WritableIPAddress{
+		Address:            &address,
+		AssignedObjectID:   &groupID,
+		AssignedObjectType: &objectType,
+		Role:               getFhrpGroupIPAddressRole(protocol, vip),
+		Status:             vip["status"].(string),
+		Tags:               []*models.NestedTag{},
+	}
+
+	params := ipam.NewIpamIPAddressesCreateParams().WithData(newIP)
+	ipCreated, err := client.Ipam.IpamIPAddressesCreate(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(ipCreated.Payload.ID), nil
+}
+
+func updateFhrpGroupIPAddress(client *netboxclient.NetBoxAPI, ipID int64,
+	protocol string, vip map[string]interface{}) error {
+	address := vip["address"].(string)
+
+	params := &models.WritableIPAddress{
+		Address: &address,
+		Role:    getFhrpGroupIPAddressRole(protocol, vip),
+		Status:  vip["status"].(string),
+	}
+
+	resource := ipam.NewIpamIPAddressesPartialUpdateParams().WithData(params)
+	resource.SetID(ipID)
+
+	_, err := client.Ipam.IpamIPAddressesPartialUpdate(resource, nil, requestmodifier.NewRequestModifierOperation(nil, []string{"nat_outside", "tags"}))
+	return err
+}
+
+func readFhrpGroupIPAddress(client *netboxclient.NetBoxAPI, stateIPAddress []interface{}) ([]interface{}, error) {
+	if len(stateIPAddress) != 1 {
+		return nil, nil
+	}
+
+	vip := stateIPAddress[0].(map[string]interface{})
+	ipID := strconv.Itoa(vip["id"].(int))
+	params := ipam.NewIpamIPAddressesListParams().WithID(&ipID)
+	ips, err := client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ips.Payload.Results) != 1 {
+		return nil, nil
+	}
+
+	ip := ips.Payload.Results[0]
+	vip["address"] = *ip.Address
+	vip["role"] = ""
+	if ip.Role != nil {
+		vip["role"] = *ip.Role.Value
+	}
+	vip["status"] = ""
+	if ip.Status != nil {
+		vip["status"] = *ip.Status.Value
+	}
+
+	return []interface{}{vip}, nil
+}
@@ -0,0 +1,236 @@
+package ipam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxIpamFhrpGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a FHRP group assignment (ipam module) within Netbox.",
+		CreateContext: resourceNetboxIpamFhrpGroupAssignmentCreate,
+		ReadContext:   resourceNetboxIpamFhrpGroupAssignmentRead,
+		UpdateContext: resourceNetboxIpamFhrpGroupAssignmentUpdate,
+		DeleteContext: resourceNetboxIpamFhrpGroupAssignmentDelete,
+		Exists:        resourceNetboxIpamFhrpGroupAssignmentExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this FHRP group assignment (ipam module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this FHRP group assignment was created.",
+			},
+			"group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the FHRP group of this FHRP group assignment (ipam module).",
+			},
+			"interface_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the interface assigned to the FHRP group.",
+			},
+			"interface_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					vMInterfaceType, "dcim.interface"}, false),
+				Description: "Type of the interface assigned to the FHRP group among virtualization.vminterface or dcim.interface.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this FHRP group assignment was last updated.",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+				Description:  "The priority of the interface in the FHRP group.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this FHRP group assignment (ipam module).",
+			},
+		},
+	}
+}
+
+func resourceNetboxIpamFhrpGroupAssignmentCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	groupID := int64(d.Get("group_id").(int))
+	interfaceID := int64(d.Get("interface_id").(int))
+	interfaceType := d.Get("interface_type").(string)
+	priority := int64(d.Get("priority").(int))
+
+	newResource := &models.WritableFHRPGroupAssignment{
+		Group:         &groupID,
+		InterfaceID:   &interfaceID,
+		InterfaceType: &interfaceType,
+		Priority:      &priority,
+	}
+
+	resource := ipam.NewIpamFhrpGroupAssignmentsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Ipam.IpamFhrpGroupAssignmentsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxIpamFhrpGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxIpamFhrpGroupAssignmentRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := ipam.NewIpamFhrpGroupAssignmentsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamFhrpGroupAssignmentsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var groupID int64
+	if resource.Group != nil {
+		groupID = resource.Group.ID
+	}
+	if err = d.Set("group_id", groupID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("interface_id", resource.InterfaceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("interface_type", resource.InterfaceType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("priority", resource.Priority); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamFhrpGroupAssignmentUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	// None of the fields of a FHRP group assignment are optional in the API
+	// model so they are always sent
+	groupID := int64(d.Get("group_id").(int))
+	interfaceID := int64(d.Get("interface_id").(int))
+	interfaceType := d.Get("interface_type").(string)
+	priority := int64(d.Get("priority").(int))
+
+	params := &models.WritableFHRPGroupAssignment{
+		Group:         &groupID,
+		InterfaceID:   &interfaceID,
+		InterfaceType: &interfaceType,
+		Priority:      &priority,
+	}
+
+	resource := ipam.NewIpamFhrpGroupAssignmentsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Ipam.IpamFhrpGroupAssignmentsPartialUpdate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpamFhrpGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxIpamFhrpGroupAssignmentDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxIpamFhrpGroupAssignmentExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := ipam.NewIpamFhrpGroupAssignmentsDeleteParams().WithID(id)
+	if _, err := client.Ipam.IpamFhrpGroupAssignmentsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamFhrpGroupAssignmentExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := ipam.NewIpamFhrpGroupAssignmentsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamFhrpGroupAssignmentsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,134 @@
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameIpamFhrpGroupAssignment = "netbox_ipam_fhrp_group_assignment.test"
+
+func TestAccNetboxIpamFhrpGroupAssignmentMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix, false, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamFhrpGroupAssignment,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupAssignmentFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix, true, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamFhrpGroupAssignment,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupAssignmentMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix, false, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix, true, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroupAssignment),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix, false, groupID),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroupAssignment),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamFhrpGroupAssignmentConfig(nameSuffix string, resourceFull bool, groupID int64) string {
+	template := `
+	resource "netbox_virtualization_cluster_type" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_virtualization_cluster" "test" {
+		name    = "test-{{ .namesuffix }}"
+		type_id = netbox_virtualization_cluster_type.test.id
+	}
+
+	resource "netbox_virtualization_vm" "test" {
+		name       = "test-{{ .namesuffix }}"
+		cluster_id = netbox_virtualization_cluster.test.id
+	}
+
+	resource "netbox_virtualization_interface" "test" {
+		name              = "test-{{ .namesuffix }}"
+		virtualmachine_id = netbox_virtualization_vm.test.id
+	}
+
+	resource "netbox_ipam_fhrp_group" "test" {
+		protocol = "vrrp3"
+		group_id = {{ .groupid }}
+	}
+
+	resource "netbox_ipam_fhrp_group_assignment" "test" {
+		group_id       = netbox_ipam_fhrp_group.test.id
+		interface_id   = netbox_virtualization_interface.test.id
+		interface_type = "virtualization.vminterface"
+		{{ if eq .resourcefull "true" }}
+		priority       = 200
+		{{ else }}
+		priority       = 100
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":   nameSuffix,
+		"resourcefull": strconv.FormatBool(resourceFull),
+		"groupid":      strconv.FormatInt(groupID, 10),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,140 @@
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameIpamFhrpGroup = "netbox_ipam_fhrp_group.test"
+
+func TestAccNetboxIpamFhrpGroupMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, false, false, groupID, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamFhrpGroup,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, true, true, groupID, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+					resource.TestCheckResourceAttr(resourceNameIpamFhrpGroup, "ip_address.0.role", "vrrp"),
+					resource.TestCheckResourceAttr(resourceNameIpamFhrpGroup, "ip_addresses.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceNameIpamFhrpGroup,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_address"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamFhrpGroupMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, false, false, groupID, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, true, true, groupID, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+					resource.TestCheckResourceAttr(resourceNameIpamFhrpGroup, "ip_addresses.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, false, true, groupID, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+					resource.TestCheckResourceAttr(resourceNameIpamFhrpGroup, "ip_addresses.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, false, false, groupID, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix string, resourceFull, extraResources bool, groupID, ipnum int64) string {
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_ipam_fhrp_group" "test" {
+		protocol    = "vrrp3"
+		group_id    = {{ .groupid }}
+		{{ if eq .resourcefull "true" }}
+		auth_type   = "plaintext"
+		auth_key    = "test-{{ .namesuffix }}"
+		description = "Test FHRP group"
+
+		ip_address {
+			address = "${cidrhost("10.0.0.0/8", {{ .ipnum }})}/24"
+		}
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+		"groupid":        strconv.FormatInt(groupID, 10),
+		"ipnum":          strconv.FormatInt(ipnum, 10),
+	}
+	return util.RenderTemplate(template, data)
+}
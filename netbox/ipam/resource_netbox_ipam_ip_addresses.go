@@ -2,8 +2,11 @@ package ipam
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +15,7 @@ import (
 	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
 	"github.com/smutel/go-netbox/v3/netbox/models"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
 )
@@ -24,6 +28,7 @@ func ResourceNetboxIpamIPAddresses() *schema.Resource {
 		UpdateContext: resourceNetboxIpamIPAddressesUpdate,
 		DeleteContext: resourceNetboxIpamIPAddressesDelete,
 		Exists:        resourceNetboxIpamIPAddressesExists,
+		CustomizeDiff: resourceNetboxIpamIPAddressesCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -37,6 +42,51 @@ func ResourceNetboxIpamIPAddresses() *schema.Resource {
 				ValidateFunc: validation.IsCIDR,
 				Description:  "The IP address (with mask) used for this IP address (ipam module). Required if both prefix and ip_range are not set.",
 			},
+			"assigned_interface": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"object_id", "object_type"},
+				Description:   "The interface where this IP address (ipam module) is assigned, either by its ID and type or by its name and the name of its device or virtual machine.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{"assigned_interface.0.id",
+								"assigned_interface.0.type",
+								"assigned_interface.0.virtualmachine_name"},
+							Description: "The name of the device of the interface.",
+						},
+						"id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The ID of the interface.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the interface. Required with device_name or virtualmachine_name.",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								vMInterfaceType, dcimInterfaceType}, false),
+							Description: "The type of the interface among virtualization.vminterface or dcim.interface. Required with id.",
+						},
+						"virtualmachine_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{"assigned_interface.0.id",
+								"assigned_interface.0.type"},
+							Description: "The name of the virtual machine of the interface.",
+						},
+					},
+				},
+			},
 			"ip_range": {
 				Type:        schema.TypeInt,
 				ForceNew:    true,
@@ -49,6 +99,12 @@ func ResourceNetboxIpamIPAddresses() *schema.Resource {
 				Optional:    true,
 				Description: "The prefix id for automatic IP assignment. Required if both address and ip_range are not set.",
 			},
+			"check_dns_name": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check that no other IP address (ipam module) of the same VRF has the same DNS name (false by default). The plan fails when the DNS name is set or changed to a duplicate one, a warning is shown when a duplicate appears later.",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -74,48 +130,64 @@ func ResourceNetboxIpamIPAddresses() *schema.Resource {
 			"nat_inside_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The ID of the NAT inside of this IP address (ipam module).",
+				Computed:    true,
+				Description: "The ID of the NAT inside of this IP address (ipam module). Computed when not set, e.g. when it is set by a netbox_ipam_nat_mapping, set it to 0 to clear it.",
+			},
+			"nat_outside_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the NAT outside of this IP address (ipam module).",
 			},
 			"object_id": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The ID of the object where this resource is attached to.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"assigned_interface"},
+				Description:   "The ID of the object where this resource is attached to.",
 			},
 			"object_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{"assigned_interface"},
 				ValidateFunc: validation.StringInSlice([]string{
 					vMInterfaceType, "dcim.interface"}, false),
 				Description: "The object type among virtualization.vminterface or dcim.interface (empty by default).",
 			},
+			"primary": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"primary_ip4"},
+				Description:   "Set this resource as primary IPv4 or IPv6 (depending on its family) of the device or the virtual machine of its interface (false by default).",
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return d.GetRawConfig().GetAttr("primary").IsNull()
+				},
+			},
 			"primary_ip4": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Deprecated:  "Use new netbox_virtualization_primary_ip resource instead",
-				Description: "Set this resource as primary IPv4 (false by default).",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"primary"},
+				Deprecated:    "Use primary instead",
+				Description:   "Set this resource as primary IPv4 (false by default).",
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 					return d.GetRawConfig().GetAttr("primary_ip4").IsNull()
 				},
 			},
 			"role": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
-				ValidateFunc: validation.StringInSlice([]string{"loopback",
-					"secondary", "anycast", "vip", "vrrp", "hsrp", "glbp", "carp"},
-					false),
-				Description: "The role among loopback, secondary, anycast, vip, vrrp, hsrp, glbp, carp of this IP address (ipam module).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The role of this IP address (ipam module) among the choices allowed by Netbox, e.g. loopback, secondary, anycast, vip, vrrp, hsrp, glbp, carp.",
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "active",
-				ValidateFunc: validation.StringInSlice([]string{"container", "active",
-					"reserved", "deprecated", "dhcp"}, false),
-				Description: "The status among of this IP address (ipam module) container, active, reserved, deprecated (active by default).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: "The status of this IP address (ipam module) among the choices allowed by Netbox, e.g. active, reserved, deprecated, dhcp, slaac (active by default).",
 			},
 			"tag": &tag.TagSchema,
 			"tenant_id": {
@@ -132,10 +204,53 @@ func ResourceNetboxIpamIPAddresses() *schema.Resource {
 	}
 }
 
+var ipAddressesCustomizeDiffChoices = util.CustomizeDiffChoices("/ipam/ip-addresses/", "role", "status")
+
+// resourceNetboxIpamIPAddressesCustomizeDiff checks the choices and, when
+// check_dns_name is set, that the planned DNS name is not a duplicate
+func resourceNetboxIpamIPAddressesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	if err := ipAddressesCustomizeDiffChoices(ctx, d, m); err != nil {
+		return err
+	}
+
+	client, ok := m.(*netboxclient.NetBoxAPI)
+	if !ok || !d.Get("check_dns_name").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown("dns_name") || !d.NewValueKnown("vrf_id") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("check_dns_name", "dns_name", "vrf_id") {
+		return nil
+	}
+
+	var id int64
+	if d.Id() != "" {
+		var err error
+		id, err = strconv.ParseInt(d.Id(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("Unable to convert ID into int64")
+		}
+	}
+
+	return checkDNSNameUnique(client, d.Get("dns_name").(string), int64(d.Get("vrf_id").(int)), id)
+}
+
 func resourceNetboxIpamIPAddressesCreate(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
 
+	// The addresses created in the same apply are not known at plan time
+	if d.Get("check_dns_name").(bool) {
+		err := checkDNSNameUnique(client, d.Get("dns_name").(string),
+			int64(d.Get("vrf_id").(int)), 0)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var address string
 	var addressid *int64
 	if stateaddress, ok := d.GetOk("address"); ok {
@@ -164,13 +279,16 @@ func resourceNetboxIpamIPAddressesCreate(ctx context.Context, d *schema.Resource
 		return diag.Errorf("exactly one of (address, ip_range, prefix) must be specified")
 	}
 
+	objectType, objectID, err := getIPAddressAssignment(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
 	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
 	description := d.Get("description").(string)
 	dnsName := d.Get("dns_name").(string)
 	natInsideID := int64(d.Get("nat_inside_id").(int))
-	objectID := int64(d.Get("object_id").(int))
-	objectType := d.Get("object_type").(string)
 	role := d.Get("role").(string)
 	status := d.Get("status").(string)
 	tags := d.Get("tag").(*schema.Set).List()
@@ -223,12 +341,12 @@ func resourceNetboxIpamIPAddressesCreate(ctx context.Context, d *schema.Resource
 	}
 
 	d.SetId(strconv.FormatInt(*addressid, 10))
-	if primaryIP := d.Get("primary_ip4").(bool); primaryIP {
-		vmID, err := getVMIDForInterface(client, objectID)
+	if primaryKey := getPrimaryKey(d); primaryKey != "" && d.Get(primaryKey).(bool) {
+		ip4, err := isIPv4Address(address, primaryKey)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		err = updatePrimaryStatus(client, vmID, *addressid, primaryIP)
+		err = setPrimary(client, objectType, objectID, *addressid, ip4, true)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -297,27 +415,49 @@ func resourceNetboxIpamIPAddressesRead(ctx context.Context, d *schema.ResourceDa
 				return diag.FromErr(err)
 			}
 
-			isPrimary, err := isprimary(m, resource.AssignedObjectID, resource.ID, (*resource.Family.Value == 4))
-			if err != nil {
-				return diag.FromErr(err)
+			natOutsideIDs := []int64{}
+			for _, natOutside := range resource.NatOutside {
+				natOutsideIDs = append(natOutsideIDs, natOutside.ID)
 			}
-			if err = d.Set("primary_ip4", isPrimary); err != nil {
+			if err = d.Set("nat_outside_ids", natOutsideIDs); err != nil {
 				return diag.FromErr(err)
 			}
 
-			if err = d.Set("object_id", resource.AssignedObjectID); err != nil {
+			ip4 := *resource.Family.Value == 4
+			primary := false
+			if resource.AssignedObjectID != nil && resource.AssignedObjectType != nil {
+				primary, err = isPrimary(client, *resource.AssignedObjectType,
+					*resource.AssignedObjectID, resource.ID, ip4)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			if err = d.Set("primary", primary); err != nil {
+				return diag.FromErr(err)
+			}
+			if err = d.Set("primary_ip4", primary && ip4); err != nil {
 				return diag.FromErr(err)
 			}
 
-			objectType := resource.AssignedObjectType
-			if objectType != nil {
-				*objectType = vMInterfaceType
-
-				if err = d.Set("object_type", *objectType); err != nil {
+			// The assignment is either tracked by the assigned_interface block or by
+			// object_id/object_type, only the one in use is refreshed
+			stateAssignedInterfaces := d.Get("assigned_interface").([]interface{})
+			if len(stateAssignedInterfaces) == 1 && stateAssignedInterfaces[0] != nil {
+				assignedInterface := []map[string]interface{}{}
+				if resource.AssignedObjectID != nil && resource.AssignedObjectType != nil {
+					stateAssignedInterface := stateAssignedInterfaces[0].(map[string]interface{})
+					assignedInterface = append(assignedInterface, readAssignedInterface(
+						stateAssignedInterface, *resource.AssignedObjectID,
+						*resource.AssignedObjectType, resource.AssignedObject))
+				}
+				if err = d.Set("assigned_interface", assignedInterface); err != nil {
 					return diag.FromErr(err)
 				}
 			} else {
-				if err = d.Set("object_type", nil); err != nil {
+				if err = d.Set("object_id", resource.AssignedObjectID); err != nil {
+					return diag.FromErr(err)
+				}
+				if err = d.Set("object_type", resource.AssignedObjectType); err != nil {
 					return diag.FromErr(err)
 				}
 			}
@@ -362,6 +502,10 @@ func resourceNetboxIpamIPAddressesRead(ctx context.Context, d *schema.ResourceDa
 				return diag.FromErr(err)
 			}
 
+			if d.Get("check_dns_name").(bool) {
+				return checkDNSNameDuplicates(client, resource)
+			}
+
 			return nil
 		}
 	}
@@ -374,8 +518,26 @@ func resourceNetboxIpamIPAddressesRead(ctx context.Context, d *schema.ResourceDa
 func resourceNetboxIpamIPAddressesUpdate(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
 	params := &models.WritableIPAddress{}
-	// primary_ip4 := false
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	if d.Get("check_dns_name").(bool) && d.HasChanges("check_dns_name", "dns_name", "vrf_id") {
+		err = checkDNSNameUnique(client, d.Get("dns_name").(string),
+			int64(d.Get("vrf_id").(int)), resourceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	objectType, objectID, err := getIPAddressAssignment(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Required parameters
 	address := d.Get("address").(string)
@@ -407,21 +569,47 @@ func resourceNetboxIpamIPAddressesUpdate(ctx context.Context, d *schema.Resource
 		natInsideID := int64(d.Get("nat_inside_id").(int))
 		if natInsideID != 0 {
 			params.NatInside = &natInsideID
+		} else {
+			modifiedFields["nat_inside"] = nil
 		}
 	}
 
-	if d.HasChange("object_id") || d.HasChange("object_type") {
-		// primary_ip4 = true
-		objectID := int64(d.Get("object_id").(int))
-		params.AssignedObjectID = &objectID
+	assignmentChanged := false
+	if d.HasChanges("assigned_interface", "object_id", "object_type") {
+		current, err := client.Ipam.IpamIPAddressesRead(
+			ipam.NewIpamIPAddressesReadParams().WithID(resourceID), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-		var objectType string
-		if *params.AssignedObjectType == "" {
-			objectType = vMInterfaceType
-		} else {
-			objectType = d.Get("object_type").(string)
+		var currentObjectID int64
+		var currentObjectType string
+		if current.Payload.AssignedObjectID != nil && current.Payload.AssignedObjectType != nil {
+			currentObjectID = *current.Payload.AssignedObjectID
+			currentObjectType = *current.Payload.AssignedObjectType
+		}
+
+		if currentObjectID != objectID || currentObjectType != objectType {
+			assignmentChanged = true
+
+			if currentObjectID != 0 {
+				err = releasePrimaryIP(client, currentObjectType, currentObjectID,
+					objectType, objectID, resourceID)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			// The interface is re-assigned in place so the IP address keeps its
+			// ID and the references to it
+			if objectID != 0 {
+				params.AssignedObjectID = &objectID
+				params.AssignedObjectType = &objectType
+			} else {
+				modifiedFields["assigned_object_id"] = nil
+				modifiedFields["assigned_object_type"] = nil
+			}
 		}
-		*params.AssignedObjectType = objectType
 	}
 
 	if d.HasChange("role") {
@@ -454,28 +642,24 @@ func resourceNetboxIpamIPAddressesUpdate(ctx context.Context, d *schema.Resource
 	resource := ipam.NewIpamIPAddressesPartialUpdateParams().WithData(
 		params)
 
-	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
-	if err != nil {
-		return diag.Errorf("Unable to convert ID into int64")
-	}
-
 	resource.SetID(resourceID)
 
-	_, err = client.Ipam.IpamIPAddressesPartialUpdate(resource, nil)
+	_, err = client.Ipam.IpamIPAddressesPartialUpdate(resource, nil,
+		requestmodifier.NewNetboxRequestModifier(modifiedFields, []string{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if !d.GetRawConfig().GetAttr("primary_ip4").IsNull() {
-		if (d.HasChange("object_id") && d.Get("primary_ip4").(bool)) ||
-			(!d.HasChange("object_id") && d.HasChange("primary_ip4")) ||
-			(d.HasChange("primary_ip4") && d.Get("primary_ip4").(bool)) {
-			objectID := int64(d.Get("object_id").(int))
-			vmID, err := getVMIDForInterface(client, objectID)
+	if primaryKey := getPrimaryKey(d); primaryKey != "" {
+		primary := d.Get(primaryKey).(bool)
+		if (assignmentChanged && primary) ||
+			(!assignmentChanged && d.HasChange(primaryKey)) ||
+			(d.HasChange(primaryKey) && primary) {
+			ip4, err := isIPv4Address(address, primaryKey)
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			err = updatePrimaryStatus(client, vmID, resourceID, d.Get("primary_ip4").(bool))
+			err = setPrimary(client, objectType, objectID, resourceID, ip4, primary)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -531,3 +715,176 @@ func resourceNetboxIpamIPAddressesExists(d *schema.ResourceData,
 
 	return resourceExist, nil
 }
+
+// getIPAddressAssignment returns the type and the ID of the interface where
+// the IP address must be assigned, from the assigned_interface block if it is
+// set or from object_type/object_id otherwise.
+func getIPAddressAssignment(client *netboxclient.NetBoxAPI,
+	d *schema.ResourceData) (string, int64, error) {
+	assignedInterfaces := d.Get("assigned_interface").([]interface{})
+	if len(assignedInterfaces) != 1 || assignedInterfaces[0] == nil {
+		return d.Get("object_type").(string), int64(d.Get("object_id").(int)), nil
+	}
+
+	assignedInterface := assignedInterfaces[0].(map[string]interface{})
+	name := assignedInterface["name"].(string)
+	deviceName := assignedInterface["device_name"].(string)
+	vmName := assignedInterface["virtualmachine_name"].(string)
+
+	if deviceName != "" || vmName != "" {
+		if name == "" {
+			return "", 0, fmt.Errorf("assigned_interface: name is required with device_name or virtualmachine_name")
+		}
+
+		objectType := vMInterfaceType
+		parentName := vmName
+		if deviceName != "" {
+			objectType = dcimInterfaceType
+			parentName = deviceName
+		}
+
+		objectID, err := getInterfaceIDByName(client, objectType, parentName, name)
+		return objectType, objectID, err
+	}
+
+	objectID := int64(assignedInterface["id"].(int))
+	objectType := assignedInterface["type"].(string)
+	if objectID == 0 || objectType == "" {
+		return "", 0, fmt.Errorf("assigned_interface: either id and type or name and device_name or virtualmachine_name are required")
+	}
+
+	return objectType, objectID, nil
+}
+
+// readAssignedInterface returns the assigned_interface block of the interface
+// objectID, the names are only refreshed when they are used to identify it.
+func readAssignedInterface(stateAssignedInterface map[string]interface{},
+	objectID int64, objectType string, assignedObject interface{}) map[string]interface{} {
+	assignedInterface := map[string]interface{}{
+		"device_name":         "",
+		"id":                  objectID,
+		"name":                "",
+		"type":                objectType,
+		"virtualmachine_name": "",
+	}
+
+	if stateAssignedInterface["device_name"] == "" &&
+		stateAssignedInterface["virtualmachine_name"] == "" {
+		return assignedInterface
+	}
+
+	object, ok := assignedObject.(map[string]interface{})
+	if !ok {
+		return assignedInterface
+	}
+
+	assignedInterface["name"], _ = object["name"].(string)
+	if device, ok := object["device"].(map[string]interface{}); ok {
+		assignedInterface["device_name"], _ = device["name"].(string)
+	}
+	if vm, ok := object["virtual_machine"].(map[string]interface{}); ok {
+		assignedInterface["virtualmachine_name"], _ = vm["name"].(string)
+	}
+
+	return assignedInterface
+}
+
+// getPrimaryKey returns the attribute (primary or the deprecated primary_ip4)
+// managing the primary status of the IP address, or an empty string when the
+// primary status is managed elsewhere.
+func getPrimaryKey(d *schema.ResourceData) string {
+	for _, key := range []string{"primary", "primary_ip4"} {
+		if !d.GetRawConfig().GetAttr(key).IsNull() {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// isIPv4Address returns true if address is an IPv4 address, primary_ip4 can't
+// be used to set an IPv6 address as primary.
+func isIPv4Address(address, primaryKey string) (bool, error) {
+	prefix, err := netip.ParsePrefix(address)
+	if err != nil {
+		return false, err
+	}
+
+	ip4 := prefix.Addr().Is4()
+	if !ip4 && primaryKey == "primary_ip4" {
+		return false, fmt.Errorf("primary_ip4 can't be set on the IPv6 address %s, use primary instead", address)
+	}
+
+	return ip4, nil
+}
+
+// checkDNSNameDuplicates returns a warning when other IP addresses of the VRF
+// of resource have the same DNS name
+func checkDNSNameDuplicates(client *netboxclient.NetBoxAPI,
+	resource *models.IPAddress) diag.Diagnostics {
+	if resource.DNSName == "" {
+		return nil
+	}
+
+	duplicates, err := getDNSNameDuplicates(client, resource.DNSName,
+		getVrfIDFilter(resource.Vrf), resource.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("DNS name %s is used by several IP addresses", resource.DNSName),
+		Detail: fmt.Sprintf("The following IP addresses of the same VRF have the DNS name of %s: %s.",
+			*resource.Address, strings.Join(duplicates, ", ")),
+	}}
+}
+
+// checkDNSNameUnique returns an error when another IP address than id in the
+// vrf vrfID (0 for the global table) has the DNS name dnsName
+func checkDNSNameUnique(client *netboxclient.NetBoxAPI, dnsName string, vrfID, id int64) error {
+	if dnsName == "" {
+		return nil
+	}
+
+	vrfIDFilter := "null"
+	if vrfID != 0 {
+		vrfIDFilter = strconv.FormatInt(vrfID, 10)
+	}
+
+	duplicates, err := getDNSNameDuplicates(client, dnsName, vrfIDFilter, id)
+	if err != nil {
+		return err
+	}
+
+	if len(duplicates) > 0 {
+		return fmt.Errorf("DNS name %s is already used by the following IP addresses of the same VRF: %s",
+			dnsName, strings.Join(duplicates, ", "))
+	}
+
+	return nil
+}
+
+// getDNSNameDuplicates returns the addresses of the IP addresses other than
+// id with the DNS name dnsName in the vrf matching the filter vrfIDFilter
+func getDNSNameDuplicates(client *netboxclient.NetBoxAPI, dnsName, vrfIDFilter string,
+	id int64) ([]string, error) {
+	params := ipam.NewIpamIPAddressesListParams().WithDNSName(&dnsName).WithVrfID(&vrfIDFilter)
+	resources, err := client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []string
+	for _, r := range resources.Payload.Results {
+		if r.ID != id {
+			duplicates = append(duplicates, *r.Address)
+		}
+	}
+
+	return duplicates, nil
+}
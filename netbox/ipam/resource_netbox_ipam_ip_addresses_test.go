@@ -0,0 +1,469 @@
+package ipam_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameIpamIPAddresses = "netbox_ipam_ip_addresses.test"
+
+func TestAccNetboxIpamIPAddressesMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamIPAddressesConfig(nameSuffix, false, false, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+				),
+			},
+			{
+				ResourceName:            resourceNameIpamIPAddresses,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"check_dns_name"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamIPAddressesFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamIPAddressesConfig(nameSuffix, true, true, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+					resource.TestCheckResourceAttrPair(resourceNameIpamIPAddresses, "assigned_interface.0.id", "netbox_dcim_interface.a", "id"),
+					resource.TestCheckResourceAttr(resourceNameIpamIPAddresses, "assigned_interface.0.type", "dcim.interface"),
+					resource.TestCheckResourceAttr(resourceNameIpamIPAddresses, "primary", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceNameIpamIPAddresses,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"assigned_interface", "check_dns_name", "object_id", "object_type"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamIPAddressesMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamIPAddressesConfig(nameSuffix, false, false, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamIPAddressesConfig(nameSuffix, true, true, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamIPAddressesConfig(nameSuffix, false, true, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+					testAccCheckNetboxDcimDevicePrimaryIP4Empty("test-a-"+nameSuffix),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamIPAddressesConfig(nameSuffix, false, false, ipnum),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamIPAddressesMovePrimary(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+	var resourceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamIPAddressesMoveConfig(nameSuffix, ipnum, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+					testAccCheckNetboxIpamIPAddressesSameID(resourceNameIpamIPAddresses, &resourceID),
+					resource.TestCheckResourceAttrPair(resourceNameIpamIPAddresses, "assigned_interface.0.id", "netbox_dcim_interface.a", "id"),
+					resource.TestCheckResourceAttr(resourceNameIpamIPAddresses, "primary", "true"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamIPAddressesMoveConfig(nameSuffix, ipnum, "b"),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+					testAccCheckNetboxIpamIPAddressesSameID(resourceNameIpamIPAddresses, &resourceID),
+					resource.TestCheckResourceAttrPair(resourceNameIpamIPAddresses, "assigned_interface.0.id", "netbox_dcim_interface.b", "id"),
+					resource.TestCheckResourceAttr(resourceNameIpamIPAddresses, "primary", "true"),
+					testAccCheckNetboxDcimDevicePrimaryIP4Empty("test-a-"+nameSuffix),
+				),
+			},
+		},
+	})
+}
+
+// The IP addresses assigned to a FHRP group are not assigned to an interface,
+// they can be imported as IP addresses but are never primary
+func TestAccNetboxIpamIPAddressesFhrpGroupImport(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	groupID := int64(acctest.RandIntRange(1, 255))
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	config := testAccCheckNetboxIpamFhrpGroupConfig(nameSuffix, true, true, groupID, ipnum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamFhrpGroup),
+					resource.TestCheckResourceAttr(resourceNameIpamFhrpGroup, "ip_addresses.#", "1"),
+				),
+			},
+			{
+				Config: config + `
+				resource "netbox_ipam_ip_addresses" "vip" {
+					address = netbox_ipam_fhrp_group.test.ip_address.0.address
+				}
+				`,
+				ResourceName: "netbox_ipam_ip_addresses.vip",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceNameIpamFhrpGroup]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceNameIpamFhrpGroup)
+					}
+					return rs.Primary.Attributes["ip_addresses.0"], nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, is := range states {
+						if is.Ephemeral.Type != "netbox_ipam_ip_addresses" {
+							continue
+						}
+						if objectType := is.Attributes["object_type"]; objectType != "ipam.fhrpgroup" {
+							return fmt.Errorf("unexpected object_type %s", objectType)
+						}
+						if primary := is.Attributes["primary"]; primary != "false" {
+							return fmt.Errorf("unexpected primary %s", primary)
+						}
+						return nil
+					}
+					return fmt.Errorf("IP address of the FHRP group not imported")
+				},
+			},
+		},
+	})
+}
+
+// The primary flag sets the primary IP of the family of the address, on the
+// device or the virtual machine of the interface
+func TestAccNetboxIpamIPAddressesPrimaryIPv6(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamIPAddressesPrimaryConfig(nameSuffix, ipnum, "dcim.interface"),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists("netbox_ipam_ip_addresses.test6"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_addresses.test4", "primary", "true"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_addresses.test6", "primary", "true"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_addresses.test6", "primary_ip4", "false"),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamIPAddressesPrimaryConfig(nameSuffix, ipnum, "virtualization.vminterface"),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists("netbox_ipam_ip_addresses.test6"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_addresses.test4", "primary", "true"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_addresses.test6", "primary", "true"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_addresses.test6", "primary_ip4", "false"),
+					testAccCheckNetboxDcimDevicePrimaryIP4Empty("test-a-"+nameSuffix),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamIPAddressesDuplicateDNSName(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	ipnum := int64(acctest.RandIntRange(1, 16384))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamIPAddressesDNSNameConfig(nameSuffix, ipnum, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamIPAddresses),
+				),
+			},
+			{
+				Config:      testAccCheckNetboxIpamIPAddressesDNSNameConfig(nameSuffix, ipnum, true),
+				ExpectError: regexp.MustCompile("is already used by the following IP addresses"),
+			},
+		},
+	})
+}
+
+// testAccCheckNetboxIpamIPAddressesSameID records the ID of the resource n
+// the first time it is called and fails when it changes afterwards
+func testAccCheckNetboxIpamIPAddressesSameID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if *id == "" {
+			*id = rs.Primary.ID
+		} else if rs.Primary.ID != *id {
+			return fmt.Errorf("%s has been recreated, ID %s instead of %s", n, rs.Primary.ID, *id)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckNetboxDcimDevicePrimaryIP4Empty fails when the device named
+// name still has a primary IPv4
+func testAccCheckNetboxDcimDevicePrimaryIP4Empty(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*netboxclient.NetBoxAPI)
+
+		params := dcim.NewDcimDevicesListParams().WithName(&name)
+		devices, err := client.Dcim.DcimDevicesList(params, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(devices.Payload.Results) != 1 {
+			return fmt.Errorf("Device %s not found", name)
+		}
+
+		if device := devices.Payload.Results[0]; device.PrimaryIp4 != nil {
+			return fmt.Errorf("Device %s still has the primary IPv4 %d", name, device.PrimaryIp4.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetboxIpamIPAddressesDevicesConfig() string {
+	return `
+	resource "netbox_dcim_site" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_manufacturer" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_type" "test" {
+		manufacturer_id = netbox_dcim_manufacturer.test.id
+		model           = "test-{{ .namesuffix }}"
+		slug            = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device_role" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_dcim_device" "a" {
+		name           = "test-a-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "a" {
+		device_id = netbox_dcim_device.a.id
+		name      = "eth0"
+		type      = "1000base-t"
+	}
+
+	resource "netbox_dcim_device" "b" {
+		name           = "test-b-{{ .namesuffix }}"
+		device_type_id = netbox_dcim_device_type.test.id
+		role_id        = netbox_dcim_device_role.test.id
+		site_id        = netbox_dcim_site.test.id
+	}
+
+	resource "netbox_dcim_interface" "b" {
+		device_id = netbox_dcim_device.b.id
+		name      = "eth0"
+		type      = "1000base-t"
+	}
+	`
+}
+
+func testAccCheckNetboxIpamIPAddressesConfig(nameSuffix string, resourceFull, extraResources bool, ipnum int64) string {
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	` + testAccCheckNetboxIpamIPAddressesDevicesConfig() + `
+	{{ end }}
+
+	resource "netbox_ipam_ip_addresses" "test" {
+		address = "${cidrhost("10.0.0.0/8", {{ .ipnum }})}/24"
+		{{ if eq .resourcefull "true" }}
+		description = "Test IP address"
+		dns_name    = "test-{{ .namesuffix }}.example.com"
+		primary     = true
+		role        = "loopback"
+		status      = "reserved"
+
+		assigned_interface {
+			device_name = netbox_dcim_device.a.name
+			name        = netbox_dcim_interface.a.name
+		}
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+		"ipnum":          strconv.FormatInt(ipnum, 10),
+	}
+	return util.RenderTemplate(template, data)
+}
+
+func testAccCheckNetboxIpamIPAddressesMoveConfig(nameSuffix string, ipnum int64, device string) string {
+	template := testAccCheckNetboxIpamIPAddressesDevicesConfig() + `
+	resource "netbox_ipam_ip_addresses" "test" {
+		address = "${cidrhost("10.0.0.0/8", {{ .ipnum }})}/24"
+		primary = true
+
+		assigned_interface {
+			device_name = netbox_dcim_device.{{ .device }}.name
+			name        = netbox_dcim_interface.{{ .device }}.name
+		}
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+		"ipnum":      strconv.FormatInt(ipnum, 10),
+		"device":     device,
+	}
+	return util.RenderTemplate(template, data)
+}
+
+func testAccCheckNetboxIpamIPAddressesPrimaryConfig(nameSuffix string, ipnum int64, objectType string) string {
+	template := testAccCheckNetboxIpamIPAddressesDevicesConfig() + `
+	resource "netbox_virtualization_cluster_type" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_virtualization_cluster" "test" {
+		name    = "test-{{ .namesuffix }}"
+		type_id = netbox_virtualization_cluster_type.test.id
+	}
+
+	resource "netbox_virtualization_vm" "test" {
+		name       = "test-{{ .namesuffix }}"
+		cluster_id = netbox_virtualization_cluster.test.id
+	}
+
+	resource "netbox_virtualization_interface" "test" {
+		name              = "eth0"
+		virtualmachine_id = netbox_virtualization_vm.test.id
+	}
+
+	resource "netbox_ipam_ip_addresses" "test4" {
+		address     = "${cidrhost("10.0.0.0/8", {{ .ipnum }})}/24"
+		object_id   = {{ if eq .objecttype "dcim.interface" }}netbox_dcim_interface.a.id{{ else }}netbox_virtualization_interface.test.id{{ end }}
+		object_type = "{{ .objecttype }}"
+		primary     = true
+	}
+
+	resource "netbox_ipam_ip_addresses" "test6" {
+		address     = "${cidrhost("2001:db8::/32", {{ .ipnum }})}/64"
+		object_id   = {{ if eq .objecttype "dcim.interface" }}netbox_dcim_interface.a.id{{ else }}netbox_virtualization_interface.test.id{{ end }}
+		object_type = "{{ .objecttype }}"
+		primary     = true
+	}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+		"ipnum":      strconv.FormatInt(ipnum, 10),
+		"objecttype": objectType,
+	}
+	return util.RenderTemplate(template, data)
+}
+
+func testAccCheckNetboxIpamIPAddressesDNSNameConfig(nameSuffix string, ipnum int64, duplicate bool) string {
+	template := `
+	resource "netbox_ipam_ip_addresses" "test" {
+		address        = "${cidrhost("10.0.0.0/8", {{ .ipnum }})}/24"
+		check_dns_name = true
+		dns_name       = "test-{{ .namesuffix }}.example.com"
+	}
+
+	{{ if eq .duplicate "true" }}
+	resource "netbox_ipam_ip_addresses" "duplicate" {
+		address        = "${cidrhost("10.0.0.0/8", {{ .ipnum }} + 1)}/24"
+		check_dns_name = true
+		dns_name       = "test-{{ .namesuffix }}.example.com"
+	}
+	{{ end }}
+	`
+	data := map[string]string{
+		"namesuffix": nameSuffix,
+		"ipnum":      strconv.FormatInt(ipnum, 10),
+		"duplicate":  strconv.FormatBool(duplicate),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -23,6 +23,7 @@ func ResourceNetboxIpamIPRange() *schema.Resource {
 		UpdateContext: resourceNetboxIpamIPRangeUpdate,
 		DeleteContext: resourceNetboxIpamIPRangeDelete,
 		Exists:        resourceNetboxIpamIPRangeExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/ipam/ip-ranges/", "status"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -64,12 +65,10 @@ func ResourceNetboxIpamIPRange() *schema.Resource {
 				Description: "ID of the role attached to this prefix (ipam module).",
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "active",
-				ValidateFunc: validation.StringInSlice([]string{"active",
-					"reserved", "deprecated"}, false),
-				Description: "Status among active, reserved, deprecated (active by default).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: "Status among the choices allowed by Netbox, e.g. active, reserved, deprecated (active by default).",
 			},
 			"tag": &tag.TagSchema,
 			"tenant_id": {
@@ -0,0 +1,351 @@
+package ipam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxIpamL2vpn() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a L2VPN (ipam module) within Netbox.",
+		CreateContext: resourceNetboxIpamL2vpnCreate,
+		ReadContext:   resourceNetboxIpamL2vpnRead,
+		UpdateContext: resourceNetboxIpamL2vpnUpdate,
+		DeleteContext: resourceNetboxIpamL2vpnDelete,
+		Exists:        resourceNetboxIpamL2vpnExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this L2VPN (ipam module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this L2VPN was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nil,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+				Description:  "The description of this L2VPN (ipam module).",
+			},
+			"export_targets": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the route targets exported by this L2VPN (ipam module).",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"import_targets": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the route targets imported by this L2VPN (ipam module).",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"identifier": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The identifier (VNI, VC ID, ...) of this L2VPN (ipam module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this L2VPN was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The name of this L2VPN (ipam module).",
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The slug of this L2VPN (ipam module).",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant where this L2VPN (ipam module) is attached.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{"vpws", "vpls",
+					"vxlan", "vxlan-evpn", "mpls-evpn", "pbb-evpn", "epl", "evpl",
+					"ep-lan", "evp-lan", "ep-tree", "evp-tree"}, false),
+				Description: "The type of this L2VPN (ipam module) among vpws, vpls, vxlan, vxlan-evpn, mpls-evpn, pbb-evpn, epl, evpl, ep-lan, evp-lan, ep-tree, evp-tree.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this L2VPN (ipam module).",
+			},
+		},
+	}
+}
+
+var l2vpnRequiredFields = []string{
+	"created",
+	"last_updated",
+	"export_targets",
+	"import_targets",
+	"name",
+	"slug",
+	"tags",
+	"type",
+}
+
+func resourceNetboxIpamL2vpnCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	exportTargets := d.Get("export_targets").(*schema.Set).List()
+	importTargets := d.Get("import_targets").(*schema.Set).List()
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+	l2vpnType := d.Get("type").(string)
+
+	newResource := &models.WritableL2VPN{
+		CustomFields:  &customFields,
+		Description:   d.Get("description").(string),
+		ExportTargets: util.ToListofInts(exportTargets),
+		ImportTargets: util.ToListofInts(importTargets),
+		Name:          &name,
+		Slug:          &slug,
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+		Type:          &l2vpnType,
+	}
+
+	if identifier := int64(d.Get("identifier").(int)); identifier != 0 {
+		newResource.Identifier = &identifier
+	}
+	if tenantID := int64(d.Get("tenant_id").(int)); tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+
+	resource := ipam.NewIpamL2vpnsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Ipam.IpamL2vpnsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxIpamL2vpnRead(ctx, d, m)
+}
+
+func resourceNetboxIpamL2vpnRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := ipam.NewIpamL2vpnsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamL2vpnsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("export_targets", util.ConvertNestedRouteTargetsToRouteTargets(resource.ExportTargets)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("import_targets", util.ConvertNestedRouteTargetsToRouteTargets(resource.ImportTargets)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("identifier", resource.Identifier); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("slug", resource.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var l2vpnType *string
+	if resource.Type != nil {
+		l2vpnType = resource.Type.Value
+	}
+	if err = d.Set("type", l2vpnType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamL2vpnUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableL2VPN{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		params.Description = d.Get("description").(string)
+		modifiedFields["description"] = params.Description
+	}
+	if d.HasChange("export_targets") {
+		params.ExportTargets = util.ToListofInts(d.Get("export_targets").(*schema.Set).List())
+	}
+	if d.HasChange("identifier") {
+		if identifier := int64(d.Get("identifier").(int)); identifier != 0 {
+			params.Identifier = &identifier
+		} else {
+			modifiedFields["identifier"] = nil
+		}
+	}
+	if d.HasChange("import_targets") {
+		params.ImportTargets = util.ToListofInts(d.Get("import_targets").(*schema.Set).List())
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("slug") {
+		slug := d.Get("slug").(string)
+		params.Slug = &slug
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+	if d.HasChange("type") {
+		l2vpnType := d.Get("type").(string)
+		params.Type = &l2vpnType
+	}
+
+	resource := ipam.NewIpamL2vpnsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Ipam.IpamL2vpnsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, l2vpnRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpamL2vpnRead(ctx, d, m)
+}
+
+func resourceNetboxIpamL2vpnDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxIpamL2vpnExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := ipam.NewIpamL2vpnsDeleteParams().WithID(id)
+	if _, err := client.Ipam.IpamL2vpnsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamL2vpnExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := ipam.NewIpamL2vpnsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamL2vpnsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -0,0 +1,262 @@
+package ipam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxIpamL2vpnTermination() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a L2VPN termination (ipam module) within Netbox.",
+		CreateContext: resourceNetboxIpamL2vpnTerminationCreate,
+		ReadContext:   resourceNetboxIpamL2vpnTerminationRead,
+		UpdateContext: resourceNetboxIpamL2vpnTerminationUpdate,
+		DeleteContext: resourceNetboxIpamL2vpnTerminationDelete,
+		Exists:        resourceNetboxIpamL2vpnTerminationExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this L2VPN termination (ipam module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this L2VPN termination was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"l2vpn_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the L2VPN of this L2VPN termination (ipam module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this L2VPN termination was last updated.",
+			},
+			"object_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the object terminating the L2VPN.",
+			},
+			"object_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"dcim.interface", "ipam.vlan", vMInterfaceType}, false),
+				Description: "Type of the object terminating the L2VPN among dcim.interface, ipam.vlan or virtualization.vminterface.",
+			},
+			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this L2VPN termination (ipam module).",
+			},
+		},
+	}
+}
+
+var l2vpnTerminationRequiredFields = []string{
+	"created",
+	"last_updated",
+	"assigned_object_id",
+	"assigned_object_type",
+	"l2vpn",
+	"tags",
+}
+
+func resourceNetboxIpamL2vpnTerminationCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	l2vpnID := int64(d.Get("l2vpn_id").(int))
+	objectID := int64(d.Get("object_id").(int))
+	objectType := d.Get("object_type").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.WritableL2VPNTermination{
+		AssignedObjectID:   &objectID,
+		AssignedObjectType: &objectType,
+		CustomFields:       &customFields,
+		L2vpn:              &l2vpnID,
+		Tags:               tag.ConvertTagsToNestedTags(tags),
+	}
+
+	resource := ipam.NewIpamL2vpnTerminationsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Ipam.IpamL2vpnTerminationsCreate(resource, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxIpamL2vpnTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxIpamL2vpnTerminationRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := ipam.NewIpamL2vpnTerminationsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamL2vpnTerminationsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var l2vpnID int64
+	if resource.L2vpn != nil {
+		l2vpnID = resource.L2vpn.ID
+	}
+	if err = d.Set("l2vpn_id", l2vpnID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("object_id", resource.AssignedObjectID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("object_type", resource.AssignedObjectType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamL2vpnTerminationUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableL2VPNTermination{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("l2vpn_id") {
+		l2vpnID := int64(d.Get("l2vpn_id").(int))
+		params.L2vpn = &l2vpnID
+	}
+	// The object ID and the object type must always be sent together
+	if d.HasChanges("object_id", "object_type") {
+		objectID := int64(d.Get("object_id").(int))
+		objectType := d.Get("object_type").(string)
+		params.AssignedObjectID = &objectID
+		params.AssignedObjectType = &objectType
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := ipam.NewIpamL2vpnTerminationsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Ipam.IpamL2vpnTerminationsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, l2vpnTerminationRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpamL2vpnTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxIpamL2vpnTerminationDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxIpamL2vpnTerminationExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := ipam.NewIpamL2vpnTerminationsDeleteParams().WithID(id)
+	if _, err := client.Ipam.IpamL2vpnTerminationsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamL2vpnTerminationExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := ipam.NewIpamL2vpnTerminationsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamL2vpnTerminationsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
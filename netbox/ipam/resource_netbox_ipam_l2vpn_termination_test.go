@@ -0,0 +1,131 @@
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameIpamL2vpnTermination = "netbox_ipam_l2vpn_termination.test"
+
+func TestAccNetboxIpamL2vpnTerminationMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpnTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamL2vpnTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamL2vpnTerminationFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpnTermination),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamL2vpnTermination,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamL2vpnTerminationMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpnTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpnTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpnTermination),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpnTermination),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamL2vpnTerminationConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_ipam_l2vpn" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+		type = "vxlan"
+	}
+
+	resource "netbox_ipam_vlan" "test" {
+		name    = "test-{{ .namesuffix }}"
+		vlan_id = 100
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_ipam_l2vpn_termination" "test" {
+		l2vpn_id    = netbox_ipam_l2vpn.test.id
+		object_id   = netbox_ipam_vlan.test.id
+		object_type = "ipam.vlan"
+		{{ if eq .resourcefull "true" }}
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -0,0 +1,139 @@
+package ipam_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameIpamL2vpn = "netbox_ipam_l2vpn.test"
+
+func TestAccNetboxIpamL2vpnMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamL2vpnConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpn),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamL2vpn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamL2vpnFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamL2vpnConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpn),
+				),
+			},
+			{
+				ResourceName:      resourceNameIpamL2vpn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamL2vpnMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamL2vpnConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpn),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamL2vpnConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpn),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamL2vpnConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpn),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamL2vpnConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamL2vpn),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamL2vpnConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	{{ if eq .extraresources "true" }}
+	resource "netbox_tenancy_tenant" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_extras_tag" "test" {
+		name = "test-{{ .namesuffix }}"
+		slug = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_route_target" "import" {
+		name = "65000:1{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_route_target" "export" {
+		name = "65000:2{{ .namesuffix }}"
+	}
+	{{ end }}
+
+	resource "netbox_ipam_l2vpn" "test" {
+		name           = "test-{{ .namesuffix }}"
+		slug           = "test-{{ .namesuffix }}"
+		type           = "vxlan-evpn"
+		{{ if eq .resourcefull "true" }}
+		description    = "Test L2VPN"
+		export_targets = [netbox_ipam_route_target.export.id]
+		identifier     = 10100
+		import_targets = [netbox_ipam_route_target.import.id]
+		tenant_id      = netbox_tenancy_tenant.test.id
+
+		tag {
+			name = netbox_extras_tag.test.name
+			slug = netbox_extras_tag.test.slug
+		}
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
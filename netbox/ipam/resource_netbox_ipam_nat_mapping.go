@@ -0,0 +1,473 @@
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+)
+
+func ResourceNetboxIpamNatMapping() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a 1:1 NAT mapping between an inside and an outside IP address (ipam module) within Netbox. An imported NAT mapping links existing IP addresses, destroying it only unlinks them.",
+		CreateContext: resourceNetboxIpamNatMappingCreate,
+		ReadContext:   resourceNetboxIpamNatMappingRead,
+		DeleteContext: resourceNetboxIpamNatMappingDelete,
+		Exists:        resourceNetboxIpamNatMappingExists,
+		CustomizeDiff: resourceNetboxIpamNatMappingCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"inside_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"inside_address", "inside_id"},
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The inside IP address (with mask) created by this NAT mapping. Required if inside_id is not set.",
+			},
+			"inside_created": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the inside IP address was created by this NAT mapping and is deleted with it, always false after an import.",
+			},
+			"inside_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of an existing inside IP address linked by this NAT mapping. Required if inside_address is not set.",
+			},
+			"inside_vrf_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"inside_id"},
+				Description:   "ID of the VRF of the inside IP address.",
+			},
+			"outside_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"outside_address", "outside_id"},
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The outside IP address (with mask) created by this NAT mapping. Required if outside_id is not set.",
+			},
+			"outside_created": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the outside IP address was created by this NAT mapping and is deleted with it, always false after an import.",
+			},
+			"outside_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of an existing outside IP address linked by this NAT mapping. Required if outside_address is not set.",
+			},
+			"outside_vrf_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"outside_id"},
+				Description:   "ID of the VRF of the outside IP address.",
+			},
+		},
+	}
+}
+
+// natMappingAddress is one side of a NAT mapping, either an existing IP
+// address or one to create
+type natMappingAddress struct {
+	id        int64
+	address   string
+	vrfID     int64
+	natInside int64
+}
+
+var natMappingFields = []string{
+	"inside_address",
+	"inside_id",
+	"inside_vrf_id",
+	"outside_address",
+	"outside_id",
+	"outside_vrf_id",
+}
+
+// resourceNetboxIpamNatMappingCustomizeDiff checks that the inside and the
+// outside IP addresses can be mapped together as soon as they are known so
+// that the plan fails instead of the apply
+func resourceNetboxIpamNatMappingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	client, ok := m.(*netboxclient.NetBoxAPI)
+	if !ok {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(natMappingFields...) {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	for _, key := range natMappingFields {
+		if !config.GetAttr(key).IsKnown() {
+			return nil
+		}
+	}
+
+	sides := make(map[string]*natMappingAddress)
+	for _, side := range []string{"inside", "outside"} {
+		var id int64
+		if !config.GetAttr(side + "_id").IsNull() {
+			id = int64(d.Get(side + "_id").(int))
+		}
+
+		mappingAddress, err := getNatMappingAddress(client, side, d.Get(side+"_address").(string),
+			int64(d.Get(side+"_vrf_id").(int)), id)
+		if err != nil {
+			return err
+		}
+		sides[side] = mappingAddress
+	}
+
+	// The outside IP address of a mapping being replaced is still the NAT of
+	// the previous inside IP address
+	if d.Id() == strconv.FormatInt(sides["outside"].id, 10) {
+		sides["outside"].natInside = 0
+	}
+
+	return checkNatMapping(sides["inside"], sides["outside"])
+}
+
+func resourceNetboxIpamNatMappingCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	inside, err := getNatMappingAddress(client, "inside", d.Get("inside_address").(string),
+		int64(d.Get("inside_vrf_id").(int)), int64(d.Get("inside_id").(int)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outside, err := getNatMappingAddress(client, "outside", d.Get("outside_address").(string),
+		int64(d.Get("outside_vrf_id").(int)), int64(d.Get("outside_id").(int)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = checkNatMapping(inside, outside); err != nil {
+		return diag.FromErr(err)
+	}
+
+	insideCreated := inside.id == 0
+	if insideCreated {
+		inside.id, err = createNatMappingAddress(client, inside, 0)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	outsideCreated := outside.id == 0
+	if outsideCreated {
+		outside.id, err = createNatMappingAddress(client, outside, inside.id)
+	} else {
+		err = updateNatInside(client, outside.id, inside.id)
+	}
+	if err != nil {
+		// The inside IP address created above is not tracked by any state yet
+		if insideCreated {
+			resource := ipam.NewIpamIPAddressesDeleteParams().WithID(inside.id)
+			if _, errDelete := client.Ipam.IpamIPAddressesDelete(resource, nil); errDelete != nil {
+				return diag.Errorf("%s (the inside IP address with ID %d created by the NAT mapping could not be deleted: %s)",
+					err, inside.id, errDelete)
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(outside.id, 10))
+
+	if err = d.Set("inside_created", insideCreated); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("outside_created", outsideCreated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpamNatMappingRead(ctx, d, m)
+}
+
+func resourceNetboxIpamNatMappingRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := ipam.NewIpamIPAddressesListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The mapping is gone when the outside IP address is deleted or unlinked
+	if len(resources.Payload.Results) != 1 || resources.Payload.Results[0].NatInside == nil {
+		d.SetId("")
+		return nil
+	}
+
+	outside := resources.Payload.Results[0]
+
+	insideID := strconv.FormatInt(outside.NatInside.ID, 10)
+	params = ipam.NewIpamIPAddressesListParams().WithID(&insideID)
+	resources, err = client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	inside := resources.Payload.Results[0]
+
+	if err = d.Set("inside_address", inside.Address); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("inside_id", inside.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var insideVrfID int64
+	if inside.Vrf != nil {
+		insideVrfID = inside.Vrf.ID
+	}
+	if err = d.Set("inside_vrf_id", insideVrfID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("outside_address", outside.Address); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("outside_id", outside.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var outsideVrfID int64
+	if outside.Vrf != nil {
+		outsideVrfID = outside.Vrf.ID
+	}
+	if err = d.Set("outside_vrf_id", outsideVrfID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamNatMappingDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxIpamNatMappingExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	outsideID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	// Addresses created by the mapping are deleted, linked ones are only
+	// unlinked
+	if d.Get("outside_created").(bool) {
+		resource := ipam.NewIpamIPAddressesDeleteParams().WithID(outsideID)
+		if _, err := client.Ipam.IpamIPAddressesDelete(resource, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if err := updateNatInside(client, outsideID, 0); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("inside_created").(bool) {
+		insideID := int64(d.Get("inside_id").(int))
+		resource := ipam.NewIpamIPAddressesDeleteParams().WithID(insideID)
+		if _, err := client.Ipam.IpamIPAddressesDelete(resource, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamNatMappingExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := ipam.NewIpamIPAddressesListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
+
+// getNatMappingAddress returns the side (inside or outside) of the NAT
+// mapping, read from Netbox when it links the existing IP address id
+func getNatMappingAddress(client *netboxclient.NetBoxAPI, side, address string,
+	vrfID, id int64) (*natMappingAddress, error) {
+	mappingAddress := &natMappingAddress{
+		address: address,
+		vrfID:   vrfID,
+	}
+
+	if id == 0 {
+		return mappingAddress, nil
+	}
+
+	idStr := strconv.FormatInt(id, 10)
+	params := ipam.NewIpamIPAddressesListParams().WithID(&idStr)
+	resources, err := client.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		return nil, fmt.Errorf("%s IP address with ID %s not found", side, idStr)
+	}
+
+	resource := resources.Payload.Results[0]
+	mappingAddress.id = resource.ID
+	mappingAddress.address = *resource.Address
+	mappingAddress.vrfID = 0
+	if resource.Vrf != nil {
+		mappingAddress.vrfID = resource.Vrf.ID
+	}
+	if resource.NatInside != nil {
+		mappingAddress.natInside = resource.NatInside.ID
+	}
+
+	return mappingAddress, nil
+}
+
+// checkNatMapping checks that the inside and the outside IP addresses can be
+// mapped together: they must sit in different VRFs or the outside IP address
+// must be public, and the outside IP address must not be the NAT of another
+// IP address.
+func checkNatMapping(inside, outside *natMappingAddress) error {
+	if inside.id != 0 && outside.natInside == inside.id {
+		return nil
+	}
+
+	if outside.natInside != 0 {
+		return fmt.Errorf("outside IP address %s is already the NAT of the IP address with ID %d",
+			outside.address, outside.natInside)
+	}
+
+	if inside.vrfID != outside.vrfID {
+		return nil
+	}
+
+	public, err := isPublicAddress(outside.address)
+	if err != nil {
+		return err
+	}
+
+	if !public {
+		return fmt.Errorf("inside IP address %s and outside IP address %s must sit "+
+			"in different VRFs or the outside IP address must be public",
+			inside.address, outside.address)
+	}
+
+	return nil
+}
+
+// isPublicAddress returns true if the IP address (with mask) is routable on
+// the Internet
+func isPublicAddress(address string) (bool, error) {
+	prefix, err := netip.ParsePrefix(address)
+	if err != nil {
+		return false, err
+	}
+
+	addr := prefix.Addr()
+	sharedAddressSpace := netip.MustParsePrefix("100.64.0.0/10")
+
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr), nil
+}
+
+func createNatMappingAddress(client *netboxclient.NetBoxAPI,
+	mappingAddress *natMappingAddress, natInsideID int64) (int64, error) {
+	newResource := &models.WritableIPAddress{
+		Address: &mappingAddress.address,
+		Tags:    []*models.NestedTag{},
+	}
+
+	if mappingAddress.vrfID != 0 {
+		newResource.Vrf = &mappingAddress.vrfID
+	}
+
+	if natInsideID != 0 {
+		newResource.NatInside = &natInsideID
+	}
+
+	resource := ipam.NewIpamIPAddressesCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Ipam.IpamIPAddressesCreate(resource, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return resourceCreated.Payload.ID, nil
+}
+
+// updateNatInside sets (or clears if natInsideID is 0) the NAT inside of the
+// IP address id
+func updateNatInside(client *netboxclient.NetBoxAPI, id, natInsideID int64) error {
+	modifiedFields := map[string]interface{}{
+		"nat_inside": natInsideID,
+	}
+	requiredFields := []string{
+		"address",
+		"nat_outside",
+		"tags",
+	}
+
+	params := &models.WritableIPAddress{}
+	if natInsideID != 0 {
+		params.NatInside = &natInsideID
+	}
+
+	resource := ipam.NewIpamIPAddressesPartialUpdateParams().WithData(params)
+	resource.SetID(id)
+
+	_, err := client.Ipam.IpamIPAddressesPartialUpdate(resource, nil,
+		requestmodifier.NewNetboxRequestModifier(modifiedFields, requiredFields))
+	return err
+}
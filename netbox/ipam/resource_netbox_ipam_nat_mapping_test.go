@@ -0,0 +1,148 @@
+package ipam_test
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+const resourceNameIpamNatMapping = "netbox_ipam_nat_mapping.test"
+
+func TestAccNetboxIpamNatMappingMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamNatMappingConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamNatMapping),
+				),
+			},
+			{
+				ResourceName:            resourceNameIpamNatMapping,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inside_created", "outside_created"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamNatMappingFull(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamNatMappingConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamNatMapping),
+					resource.TestCheckResourceAttrPair("netbox_ipam_ip_addresses.outside", "nat_inside_id", "netbox_ipam_ip_addresses.inside", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceNameIpamNatMapping,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inside_created", "outside_created"},
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamNatMappingMinimalFullMinimal(t *testing.T) {
+	nameSuffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNetboxIpamNatMappingConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamNatMapping),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamNatMappingConfig(nameSuffix, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamNatMapping),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamNatMappingConfig(nameSuffix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamNatMapping),
+				),
+			},
+			{
+				Config: testAccCheckNetboxIpamNatMappingConfig(nameSuffix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					util.TestAccResourceExists(resourceNameIpamNatMapping),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxIpamNatMappingPrivateOutside(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "netbox_ipam_nat_mapping" "test" {
+					inside_address  = "10.20.30.50/32"
+					outside_address = "10.20.30.51/32"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must sit in different VRFs"),
+			},
+		},
+	})
+}
+
+func testAccCheckNetboxIpamNatMappingConfig(nameSuffix string, resourceFull, extraResources bool) string {
+	template := `
+	resource "netbox_ipam_vrf" "test" {
+		name = "test-{{ .namesuffix }}"
+	}
+
+	resource "netbox_ipam_ip_addresses" "inside" {
+		address = "10.20.30.40/32"
+	}
+
+	{{ if eq .extraresources "true" }}
+	resource "netbox_ipam_ip_addresses" "outside" {
+		address = "10.20.30.41/32"
+		vrf_id  = netbox_ipam_vrf.test.id
+	}
+	{{ end }}
+
+	resource "netbox_ipam_nat_mapping" "test" {
+		inside_id = netbox_ipam_ip_addresses.inside.id
+		{{ if eq .resourcefull "true" }}
+		outside_id = netbox_ipam_ip_addresses.outside.id
+		{{ else }}
+		outside_address = "203.0.113.10/32"
+		{{ end }}
+	}
+	`
+	data := map[string]string{
+		"namesuffix":     nameSuffix,
+		"resourcefull":   strconv.FormatBool(resourceFull),
+		"extraresources": strconv.FormatBool(extraResources),
+	}
+	return util.RenderTemplate(template, data)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
 	"github.com/smutel/go-netbox/v3/netbox/models"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
 )
@@ -23,6 +24,7 @@ func ResourceNetboxIpamPrefix() *schema.Resource {
 		UpdateContext: resourceNetboxIpamPrefixUpdate,
 		DeleteContext: resourceNetboxIpamPrefixDelete,
 		Exists:        resourceNetboxIpamPrefixExists,
+		CustomizeDiff: util.CustomizeDiffChoices("/ipam/prefixes/", "status"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -47,6 +49,12 @@ func ResourceNetboxIpamPrefix() *schema.Resource {
 				Default:     nil,
 				Description: "Define if this object is a pool (false by default).",
 			},
+			"mark_utilized": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Treat this prefix (ipam module) as 100% utilized (false by default).",
+			},
 			"prefix": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -87,12 +95,10 @@ func ResourceNetboxIpamPrefix() *schema.Resource {
 				Description: "ID of the site where this prefix (ipam module) is located.",
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "active",
-				ValidateFunc: validation.StringInSlice([]string{"container", "active",
-					"reserved", "deprecated"}, false),
-				Description: "Status among container, active, reserved, deprecated (active by default).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "active",
+				Description: "Status among the choices allowed by Netbox, e.g. container, active, reserved, deprecated (active by default).",
 			},
 			"tag": &tag.TagSchema,
 			"tenant_id": {
@@ -153,6 +159,7 @@ func resourceNetboxIpamPrefixCreate(ctx context.Context, d *schema.ResourceData,
 		CustomFields: &customFields,
 		Description:  description,
 		IsPool:       isPool,
+		MarkUtilized: d.Get("mark_utilized").(bool),
 		Prefix:       &prefix,
 		Status:       status,
 		Tags:         tag.ConvertTagsToNestedTags(tags),
@@ -242,6 +249,10 @@ func resourceNetboxIpamPrefixRead(ctx context.Context, d *schema.ResourceData,
 				return diag.FromErr(err)
 			}
 
+			if err = d.Set("mark_utilized", resource.MarkUtilized); err != nil {
+				return diag.FromErr(err)
+			}
+
 			if err = d.Set("prefix", resource.Prefix); err != nil {
 				return diag.FromErr(err)
 			}
@@ -321,6 +332,7 @@ func resourceNetboxIpamPrefixRead(ctx context.Context, d *schema.ResourceData,
 func resourceNetboxIpamPrefixUpdate(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
 	params := &models.WritablePrefix{}
 
 	// Required parameters
@@ -343,6 +355,11 @@ func resourceNetboxIpamPrefixUpdate(ctx context.Context, d *schema.ResourceData,
 
 	params.IsPool = d.Get("is_pool").(bool)
 
+	if d.HasChange("mark_utilized") {
+		params.MarkUtilized = d.Get("mark_utilized").(bool)
+		modifiedFields["mark_utilized"] = params.MarkUtilized
+	}
+
 	if d.HasChange("role_id") {
 		roleID := int64(d.Get("role_id").(int))
 		if roleID != 0 {
@@ -394,7 +411,7 @@ func resourceNetboxIpamPrefixUpdate(ctx context.Context, d *schema.ResourceData,
 
 	resource.SetID(resourceID)
 
-	_, err = client.Ipam.IpamPrefixesPartialUpdate(resource, nil)
+	_, err = client.Ipam.IpamPrefixesPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, []string{}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -2,7 +2,9 @@ package ipam
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,7 +41,11 @@ func ResourceNetboxIpamService() *schema.Resource {
 				Optional:     true,
 				Default:      nil,
 				ValidateFunc: validation.StringLenBetween(1, 200),
-				Description:  "The description of this service (ipam module).",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The description is copied from the service template when not set
+					return new == "" && d.Get("service_template_id").(int) != 0
+				},
+				Description: "The description of this service (ipam module).",
 			},
 			"device_id": {
 				Type:         schema.TypeInt,
@@ -62,18 +68,27 @@ func ResourceNetboxIpamService() *schema.Resource {
 				Description:  "The name for this service (ipam module).",
 			},
 			"ports": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"ports", "service_template_id"},
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
-				Description: "Array of ports of this service (ipam module).",
+				Description: "Array of ports of this service (ipam module). Copied from the service template when not set.",
 			},
 			"protocol": {
 				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
-				Description:  "The protocol of this service (ipam module) (tcp or udp).",
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"protocol", "service_template_id"},
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "sctp"}, false),
+				Description:  "The protocol of this service (ipam module) (tcp, udp or sctp). Copied from the service template when not set.",
+			},
+			"service_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the service template used to create this service (ipam module). The ports, protocol and description of the template are copied when they are not set and a warning is reported when the service diverges from the template.",
 			},
 			"tag": &tag.TagSchema,
 			"virtualmachine_id": {
@@ -128,6 +143,14 @@ func resourceNetboxIpamServiceCreate(ctx context.Context, d *schema.ResourceData
 		newResource.VirtualMachine = &virtualmachineID
 	}
 
+	if serviceTemplateID := int64(d.Get("service_template_id").(int)); serviceTemplateID != 0 {
+		serviceTemplate, err := getServiceTemplate(client, serviceTemplateID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		applyServiceTemplate(d, serviceTemplate, newResource)
+	}
+
 	resource := ipam.NewIpamServicesCreateParams().WithData(newResource)
 
 	resourceCreated, err := client.Ipam.IpamServicesCreate(resource, nil)
@@ -206,6 +229,15 @@ func resourceNetboxIpamServiceRead(ctx context.Context, d *schema.ResourceData,
 				return diag.FromErr(err)
 			}
 
+			var diags diag.Diagnostics
+			if serviceTemplateID := int64(d.Get("service_template_id").(int)); serviceTemplateID != 0 {
+				serviceTemplate, err := findServiceTemplate(client, serviceTemplateID)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				diags = checkServiceTemplateDrift(serviceTemplateID, serviceTemplate, resource)
+			}
+
 			if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
 				return diag.FromErr(err)
 			}
@@ -220,7 +252,7 @@ func resourceNetboxIpamServiceRead(ctx context.Context, d *schema.ResourceData,
 				}
 			}
 
-			return nil
+			return diags
 		}
 	}
 
@@ -283,6 +315,16 @@ func resourceNetboxIpamServiceUpdate(ctx context.Context, d *schema.ResourceData
 	tags := d.Get("tag").(*schema.Set).List()
 	params.Tags = tag.ConvertTagsToNestedTags(tags)
 
+	if d.HasChange("service_template_id") {
+		if serviceTemplateID := int64(d.Get("service_template_id").(int)); serviceTemplateID != 0 {
+			serviceTemplate, err := getServiceTemplate(client, serviceTemplateID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			applyServiceTemplate(d, serviceTemplate, params)
+		}
+	}
+
 	resource := ipam.NewIpamServicesPartialUpdateParams().WithData(
 		params)
 
@@ -347,3 +389,82 @@ func resourceNetboxIpamServiceExists(d *schema.ResourceData, m interface{}) (b b
 
 	return resourceExist, nil
 }
+
+// Return the service template with the id or an error when it does not exist
+func getServiceTemplate(client *netboxclient.NetBoxAPI, id int64) (*models.ServiceTemplate, error) {
+	serviceTemplate, err := findServiceTemplate(client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if serviceTemplate == nil {
+		return nil, fmt.Errorf("service template %d not found", id)
+	}
+
+	return serviceTemplate, nil
+}
+
+// Return the service template with the id or nil when it does not exist
+func findServiceTemplate(client *netboxclient.NetBoxAPI, id int64) (*models.ServiceTemplate, error) {
+	serviceTemplateID := strconv.FormatInt(id, 10)
+	params := ipam.NewIpamServiceTemplatesListParams().WithID(&serviceTemplateID)
+	serviceTemplates, err := client.Ipam.IpamServiceTemplatesList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serviceTemplates.Payload.Results) != 1 {
+		return nil, nil
+	}
+
+	return serviceTemplates.Payload.Results[0], nil
+}
+
+// Copy the ports, protocol and description of the service template into
+// the service when they are not set in the configuration
+func applyServiceTemplate(d *schema.ResourceData, serviceTemplate *models.ServiceTemplate,
+	params *models.WritableService) {
+	config := d.GetRawConfig()
+	if config.GetAttr("description").IsNull() {
+		params.Description = serviceTemplate.Description
+	}
+	if config.GetAttr("ports").IsNull() {
+		params.Ports = serviceTemplate.Ports
+	}
+	if config.GetAttr("protocol").IsNull() && serviceTemplate.Protocol != nil {
+		params.Protocol = serviceTemplate.Protocol.Value
+	}
+}
+
+func checkServiceTemplateDrift(serviceTemplateID int64, serviceTemplate *models.ServiceTemplate,
+	service *models.Service) diag.Diagnostics {
+	if serviceTemplate == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Service template %d does not exist", serviceTemplateID),
+			Detail:   "The service template used to create this service has been deleted.",
+		}}
+	}
+
+	var diverged []string
+	if service.Description != serviceTemplate.Description {
+		diverged = append(diverged, "description")
+	}
+	if fmt.Sprint(service.Ports) != fmt.Sprint(serviceTemplate.Ports) {
+		diverged = append(diverged, "ports")
+	}
+	if service.Protocol == nil || serviceTemplate.Protocol == nil ||
+		*service.Protocol.Value != *serviceTemplate.Protocol.Value {
+		diverged = append(diverged, "protocol")
+	}
+
+	if len(diverged) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Service %s has diverged from service template %s", *service.Name, *serviceTemplate.Name),
+		Detail:   fmt.Sprintf("The following attributes differ from the service template: %s.", strings.Join(diverged, ", ")),
+	}}
+}
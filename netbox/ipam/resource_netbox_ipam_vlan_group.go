@@ -2,6 +2,7 @@ package ipam
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strconv"
 
@@ -11,10 +12,23 @@ import (
 	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
 	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
 	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
 	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
 )
 
+// Content types which can be used as scope of a vlan group
+var vlanGroupScopeTypes = []string{
+	"dcim.region",
+	"dcim.sitegroup",
+	"dcim.site",
+	"dcim.location",
+	"dcim.rack",
+	"virtualization.clustergroup",
+	"virtualization.cluster",
+}
+
 func ResourceNetboxIpamVlanGroup() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Manage a vlan group (ipam module) within Netbox.",
@@ -23,22 +37,73 @@ func ResourceNetboxIpamVlanGroup() *schema.Resource {
 		UpdateContext: resourceNetboxIpamVlanGroupUpdate,
 		DeleteContext: resourceNetboxIpamVlanGroupDelete,
 		Exists:        resourceNetboxIpamVlanGroupExists,
+		CustomizeDiff: resourceNetboxIpamVlanGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"available_vids": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of VLAN IDs still available in this vlan group (ipam module).",
+			},
 			"content_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The content type of this vlan group (ipam module).",
 			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this vlan group was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nil,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+				Description:  "The description of this vlan group (ipam module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this vlan group was last updated.",
+			},
+			"max_vid": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4094,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "The highest VLAN ID allowed in this vlan group (ipam module) (4094 by default).",
+			},
+			"min_vid": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "The lowest VLAN ID allowed in this vlan group (ipam module) (1 by default).",
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 50),
 				Description:  "The name for this vlan group (ipam module).",
 			},
+			"scope_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"scope_type"},
+				Description:  "ID of the object used as scope of this vlan group (ipam module).",
+			},
+			"scope_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"scope_id"},
+				ValidateFunc: validation.StringInSlice(vlanGroupScopeTypes, false),
+				Description:  "Content type of the object used as scope of this vlan group (ipam module) among dcim.region, dcim.sitegroup, dcim.site, dcim.location, dcim.rack, virtualization.clustergroup, virtualization.cluster.",
+			},
 			"slug": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,22 +113,69 @@ func ResourceNetboxIpamVlanGroup() *schema.Resource {
 				Description: "The slug for this vlan group (ipam module).",
 			},
 			"tag": &tag.TagSchema,
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this vlan group (ipam module).",
+			},
+			"vlan_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of VLAN IDs used in this vlan group (ipam module).",
+			},
 		},
 	}
 }
 
+var vlanGroupRequiredFields = []string{
+	"created",
+	"last_updated",
+	"name",
+	"slug",
+	"tags",
+}
+
+// resourceNetboxIpamVlanGroupCustomizeDiff checks that the VLAN ID range is
+// not reversed so that the plan fails instead of the apply
+func resourceNetboxIpamVlanGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff,
+	m interface{}) error {
+	if !d.NewValueKnown("min_vid") || !d.NewValueKnown("max_vid") {
+		return nil
+	}
+
+	minVid := d.Get("min_vid").(int)
+	maxVid := d.Get("max_vid").(int)
+	if minVid > maxVid {
+		return fmt.Errorf("min_vid (%d) must be lower than or equal to max_vid (%d)", minVid, maxVid)
+	}
+
+	return nil
+}
+
 func resourceNetboxIpamVlanGroupCreate(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
 
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
 	groupName := d.Get("name").(string)
 	groupSlug := d.Get("slug").(string)
 	tags := d.Get("tag").(*schema.Set).List()
 
 	newResource := &models.VLANGroup{
-		Name: &groupName,
-		Slug: &groupSlug,
-		Tags: tag.ConvertTagsToNestedTags(tags),
+		CustomFields: &customFields,
+		Description:  d.Get("description").(string),
+		MaxVid:       int64(d.Get("max_vid").(int)),
+		MinVid:       int64(d.Get("min_vid").(int)),
+		Name:         &groupName,
+		Slug:         &groupSlug,
+		Tags:         tag.ConvertTagsToNestedTags(tags),
+	}
+
+	if scopeType := d.Get("scope_type").(string); scopeType != "" {
+		scopeID := int64(d.Get("scope_id").(int))
+		newResource.ScopeID = &scopeID
+		newResource.ScopeType = &scopeType
 	}
 
 	resource := ipam.NewIpamVlanGroupsCreateParams().WithData(newResource)
@@ -74,6 +186,7 @@ func resourceNetboxIpamVlanGroupCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
 	return resourceNetboxIpamVlanGroupRead(ctx, d, m)
 }
 
@@ -88,58 +201,122 @@ func resourceNetboxIpamVlanGroupRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	for _, resource := range resources.Payload.Results {
-		if strconv.FormatInt(resource.ID, 10) == d.Id() {
-			if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
-				return diag.FromErr(err)
-			}
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
 
-			if err = d.Set("name", resource.Name); err != nil {
-				return diag.FromErr(err)
-			}
+	resource := resources.Payload.Results[0]
 
-			if err = d.Set("slug", resource.Slug); err != nil {
-				return diag.FromErr(err)
-			}
+	availableVids := resource.MaxVid - resource.MinVid + 1 - resource.VlanCount
+	if err = d.Set("available_vids", availableVids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
 
-			if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
-				return diag.FromErr(err)
-			}
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
 
-			return nil
-		}
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("max_vid", resource.MaxVid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("min_vid", resource.MinVid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("scope_id", resource.ScopeID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("scope_type", resource.ScopeType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("slug", resource.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("vlan_count", resource.VlanCount); err != nil {
+		return diag.FromErr(err)
 	}
 
-	d.SetId("")
 	return nil
 }
 
 func resourceNetboxIpamVlanGroupUpdate(ctx context.Context, d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
-	params := &models.VLANGroup{}
-
-	// Required parameters
-	name := d.Get("name").(string)
-	params.Name = &name
-
-	slug := d.Get("slug").(string)
-	params.Slug = &slug
-
-	tags := d.Get("tag").(*schema.Set).List()
-	params.Tags = tag.ConvertTagsToNestedTags(tags)
-
-	resource := ipam.NewIpamVlanGroupsPartialUpdateParams().WithData(
-		params)
+	modifiedFields := make(map[string]interface{})
 
 	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.Errorf("Unable to convert ID into int64")
 	}
+	params := &models.VLANGroup{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		params.Description = d.Get("description").(string)
+		modifiedFields["description"] = params.Description
+	}
+	if d.HasChange("max_vid") {
+		params.MaxVid = int64(d.Get("max_vid").(int))
+	}
+	if d.HasChange("min_vid") {
+		params.MinVid = int64(d.Get("min_vid").(int))
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	// The scope ID and the scope type must always be sent together
+	if d.HasChanges("scope_id", "scope_type") {
+		if scopeType := d.Get("scope_type").(string); scopeType != "" {
+			scopeID := int64(d.Get("scope_id").(int))
+			params.ScopeID = &scopeID
+			params.ScopeType = &scopeType
+		} else {
+			modifiedFields["scope_id"] = nil
+			modifiedFields["scope_type"] = nil
+		}
+	}
+	if d.HasChange("slug") {
+		slug := d.Get("slug").(string)
+		params.Slug = &slug
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+
+	resource := ipam.NewIpamVlanGroupsPartialUpdateParams().WithData(params)
 
 	resource.SetID(resourceID)
 
-	_, err = client.Ipam.IpamVlanGroupsPartialUpdate(resource, nil)
+	_, err = client.Ipam.IpamVlanGroupsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, vlanGroupRequiredFields))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -147,7 +324,8 @@ func resourceNetboxIpamVlanGroupUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceNetboxIpamVlanGroupRead(ctx, d, m)
 }
 
-func resourceNetboxIpamVlanGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceNetboxIpamVlanGroupDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
 	client := m.(*netboxclient.NetBoxAPI)
 
 	resourceExists, err := resourceNetboxIpamVlanGroupExists(d, m)
@@ -172,8 +350,8 @@ func resourceNetboxIpamVlanGroupDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func resourceNetboxIpamVlanGroupExists(d *schema.ResourceData, m interface{}) (b bool,
-	e error) {
+func resourceNetboxIpamVlanGroupExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
 	client := m.(*netboxclient.NetBoxAPI)
 	resourceExist := false
 
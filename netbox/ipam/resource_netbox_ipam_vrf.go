@@ -0,0 +1,348 @@
+package ipam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
+	"github.com/smutel/go-netbox/v3/netbox/models"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/customfield"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/requestmodifier"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/tag"
+	"github.com/smutel/terraform-provider-netbox/v6/netbox/internal/util"
+)
+
+func ResourceNetboxIpamVrf() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a vrf (ipam module) within Netbox.",
+		CreateContext: resourceNetboxIpamVrfCreate,
+		ReadContext:   resourceNetboxIpamVrfRead,
+		UpdateContext: resourceNetboxIpamVrfUpdate,
+		DeleteContext: resourceNetboxIpamVrfDelete,
+		Exists:        resourceNetboxIpamVrfExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of this vrf (ipam module).",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this vrf was created.",
+			},
+			"custom_field": &customfield.CustomFieldSchema,
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nil,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+				Description:  "The description of this vrf (ipam module).",
+			},
+			"enforce_unique": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Prevent duplicate prefixes/IP addresses within this vrf (true by default).",
+			},
+			"export_targets": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the route targets exported by this vrf (ipam module).",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"import_targets": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the route targets imported by this vrf (ipam module).",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"ipaddress_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of IP addresses in this vrf (ipam module).",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when this vrf was last updated.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The name of this vrf (ipam module).",
+			},
+			"prefix_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of prefixes in this vrf (ipam module).",
+			},
+			"rd": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 21),
+				Description:  "The route distinguisher of this vrf (ipam module) as defined in RFC 4364.",
+			},
+			"tag": &tag.TagSchema,
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the tenant where this vrf (ipam module) is attached.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The link to this vrf (ipam module).",
+			},
+		},
+	}
+}
+
+var vrfRequiredFields = []string{
+	"created",
+	"last_updated",
+	"export_targets",
+	"import_targets",
+	"name",
+	"tags",
+}
+
+func resourceNetboxIpamVrfCreate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(nil, resourceCustomFields)
+	exportTargets := d.Get("export_targets").(*schema.Set).List()
+	importTargets := d.Get("import_targets").(*schema.Set).List()
+	name := d.Get("name").(string)
+	tags := d.Get("tag").(*schema.Set).List()
+
+	newResource := &models.WritableVRF{
+		CustomFields:  &customFields,
+		Description:   d.Get("description").(string),
+		EnforceUnique: d.Get("enforce_unique").(bool),
+		ExportTargets: util.ToListofInts(exportTargets),
+		ImportTargets: util.ToListofInts(importTargets),
+		Name:          &name,
+		Tags:          tag.ConvertTagsToNestedTags(tags),
+	}
+
+	if rd := d.Get("rd").(string); rd != "" {
+		newResource.Rd = &rd
+	}
+	if tenantID := int64(d.Get("tenant_id").(int)); tenantID != 0 {
+		newResource.Tenant = &tenantID
+	}
+
+	dropFields := []string{}
+	emptyFields := make(map[string]interface{})
+	if !newResource.EnforceUnique {
+		emptyFields["enforce_unique"] = false
+	}
+
+	resource := ipam.NewIpamVrfsCreateParams().WithData(newResource)
+
+	resourceCreated, err := client.Ipam.IpamVrfsCreate(resource, nil, requestmodifier.NewRequestModifierOperation(emptyFields, dropFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(resourceCreated.Payload.ID, 10))
+
+	return resourceNetboxIpamVrfRead(ctx, d, m)
+}
+
+func resourceNetboxIpamVrfRead(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceID := d.Id()
+	params := ipam.NewIpamVrfsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamVrfsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(resources.Payload.Results) != 1 {
+		d.SetId("")
+		return nil
+	}
+
+	resource := resources.Payload.Results[0]
+
+	if err = d.Set("content_type", util.ConvertURIContentType(resource.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("created", resource.Created.String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceCustomFields := d.Get("custom_field").(*schema.Set).List()
+	customFields := customfield.UpdateCustomFieldsFromAPI(resourceCustomFields, resource.CustomFields)
+	if err = d.Set("custom_field", customFields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("description", resource.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enforce_unique", resource.EnforceUnique); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("export_targets", util.ConvertNestedRouteTargetsToRouteTargets(resource.ExportTargets)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("import_targets", util.ConvertNestedRouteTargetsToRouteTargets(resource.ImportTargets)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ipaddress_count", resource.IpaddressCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_updated", resource.LastUpdated.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", resource.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("prefix_count", resource.PrefixCount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rd", resource.Rd); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tag", tag.ConvertNestedTagsToTags(resource.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("tenant_id", util.GetNestedTenantID(resource.Tenant)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", resource.URL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamVrfUpdate(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+	modifiedFields := make(map[string]interface{})
+
+	resourceID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+	params := &models.WritableVRF{}
+
+	if d.HasChange("custom_field") {
+		stateCustomFields, resourceCustomFields := d.GetChange("custom_field")
+		customFields := customfield.ConvertCustomFieldsFromTerraformToAPI(stateCustomFields.(*schema.Set).List(), resourceCustomFields.(*schema.Set).List())
+		params.CustomFields = &customFields
+	}
+	if d.HasChange("description") {
+		params.Description = d.Get("description").(string)
+		modifiedFields["description"] = params.Description
+	}
+	if d.HasChange("enforce_unique") {
+		params.EnforceUnique = d.Get("enforce_unique").(bool)
+		modifiedFields["enforce_unique"] = params.EnforceUnique
+	}
+	if d.HasChange("export_targets") {
+		params.ExportTargets = util.ToListofInts(d.Get("export_targets").(*schema.Set).List())
+	}
+	if d.HasChange("import_targets") {
+		params.ImportTargets = util.ToListofInts(d.Get("import_targets").(*schema.Set).List())
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		params.Name = &name
+	}
+	if d.HasChange("rd") {
+		if rd := d.Get("rd").(string); rd != "" {
+			params.Rd = &rd
+		} else {
+			modifiedFields["rd"] = nil
+		}
+	}
+	if d.HasChange("tag") {
+		tags := d.Get("tag").(*schema.Set).List()
+		params.Tags = tag.ConvertTagsToNestedTags(tags)
+	}
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		params.Tenant = &tenantID
+		modifiedFields["tenant"] = tenantID
+	}
+
+	resource := ipam.NewIpamVrfsPartialUpdateParams().WithData(params)
+
+	resource.SetID(resourceID)
+
+	_, err = client.Ipam.IpamVrfsPartialUpdate(resource, nil, requestmodifier.NewNetboxRequestModifier(modifiedFields, vrfRequiredFields))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxIpamVrfRead(ctx, d, m)
+}
+
+func resourceNetboxIpamVrfDelete(ctx context.Context, d *schema.ResourceData,
+	m interface{}) diag.Diagnostics {
+	client := m.(*netboxclient.NetBoxAPI)
+
+	resourceExists, err := resourceNetboxIpamVrfExists(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !resourceExists {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to convert ID into int64")
+	}
+
+	resource := ipam.NewIpamVrfsDeleteParams().WithID(id)
+	if _, err := client.Ipam.IpamVrfsDelete(resource, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetboxIpamVrfExists(d *schema.ResourceData,
+	m interface{}) (b bool, e error) {
+	client := m.(*netboxclient.NetBoxAPI)
+	resourceExist := false
+
+	resourceID := d.Id()
+	params := ipam.NewIpamVrfsListParams().WithID(&resourceID)
+	resources, err := client.Ipam.IpamVrfsList(params, nil)
+	if err != nil {
+		return resourceExist, err
+	}
+
+	for _, resource := range resources.Payload.Results {
+		if strconv.FormatInt(resource.ID, 10) == d.Id() {
+			resourceExist = true
+		}
+	}
+
+	return resourceExist, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	netboxclient "github.com/smutel/go-netbox/v3/netbox/client"
+	"github.com/smutel/go-netbox/v3/netbox/client/dcim"
 	"github.com/smutel/go-netbox/v3/netbox/client/ipam"
 	"github.com/smutel/go-netbox/v3/netbox/client/virtualization"
 	"github.com/smutel/go-netbox/v3/netbox/models"
@@ -14,6 +15,12 @@ import (
 // Type of vm interface in Netbox
 const vMInterfaceType string = "virtualization.vminterface"
 
+// Type of device interface in Netbox
+const dcimInterfaceType string = "dcim.interface"
+
+// Type of FHRP group in Netbox
+const fhrpGroupType string = "ipam.fhrpgroup"
+
 func getNewAvailableIPForIPRange(client *netboxclient.NetBoxAPI, id int64) (*models.IPAddress, error) {
 	params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(id)
 	params.Data = []*models.WritableAvailableIP{
@@ -50,6 +57,15 @@ func getNewAvailablePrefix(client *netboxclient.NetBoxAPI, id int64, length int6
 	return list.Payload[0], nil
 }
 
+// getVrfIDFilter returns the value of the vrf_id filter of the list endpoints
+// matching the objects of vrf, "null" matching the global table.
+func getVrfIDFilter(vrf *models.NestedVRF) string {
+	if vrf == nil {
+		return "null"
+	}
+	return strconv.FormatInt(vrf.ID, 10)
+}
+
 func getVMIDForInterface(m interface{}, objectID int64) (int64, error) {
 	client := m.(*netboxclient.NetBoxAPI)
 
@@ -70,64 +86,118 @@ func getVMIDForInterface(m interface{}, objectID int64) (int64, error) {
 			}
 		}
 	}
-	return 0, fmt.Errorf("virtual machine not found")
+	return 0, fmt.Errorf("Interface %d is not attached to a virtual machine.", objectID)
 }
 
-func isprimary(m interface{}, objectID *int64, ipID int64, ip4 bool) (bool, error) {
-	client := m.(*netboxclient.NetBoxAPI)
+// getPrimaryIPs returns the IDs of the primary IPv4 and IPv6 addresses of the
+// device or of the virtual machine parentID depending on objectType.
+func getPrimaryIPs(client *netboxclient.NetBoxAPI, objectType string,
+	parentID int64) (int64, int64, error) {
+	var primaryIP4, primaryIP6 *models.NestedIPAddress
+
+	if objectType == dcimInterfaceType {
+		device, err := client.Dcim.DcimDevicesRead(
+			dcim.NewDcimDevicesReadParams().WithID(parentID), nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		primaryIP4, primaryIP6 = device.Payload.PrimaryIp4, device.Payload.PrimaryIp6
+	} else {
+		vm, err := client.Virtualization.VirtualizationVirtualMachinesRead(
+			virtualization.NewVirtualizationVirtualMachinesReadParams().WithID(parentID), nil)
+		if err != nil {
+			return 0, 0, err
+		}
+		primaryIP4, primaryIP6 = vm.Payload.PrimaryIp4, vm.Payload.PrimaryIp6
+	}
+
+	var primaryIP4ID, primaryIP6ID int64
+	if primaryIP4 != nil {
+		primaryIP4ID = primaryIP4.ID
+	}
+	if primaryIP6 != nil {
+		primaryIP6ID = primaryIP6.ID
+	}
+
+	return primaryIP4ID, primaryIP6ID, nil
+}
+
+// isInterfaceType returns true if objectType is an interface of a device or of
+// a virtual machine, the only assignments which can hold a primary IP.
+func isInterfaceType(objectType string) bool {
+	return objectType == dcimInterfaceType || objectType == vMInterfaceType
+}
 
-	if objectID == nil {
+// isPrimary returns true if the IP address ipID is the primary IP address of
+// its family on the device or the virtual machine of the interface objectID.
+func isPrimary(client *netboxclient.NetBoxAPI, objectType string, objectID,
+	ipID int64, ip4 bool) (bool, error) {
+	if !isInterfaceType(objectType) {
 		return false, nil
 	}
 
-	objectIDStr := strconv.FormatInt(*objectID, 10)
-	paramsInterface := virtualization.NewVirtualizationInterfacesListParams().WithID(
-		&objectIDStr)
-	interfaces, err := client.Virtualization.VirtualizationInterfacesList(
-		paramsInterface, nil)
+	parentID, err := getInterfaceParentID(client, objectType, objectID)
+	if err != nil {
+		return false, err
+	}
 
+	primaryIP4ID, primaryIP6ID, err := getPrimaryIPs(client, objectType, parentID)
 	if err != nil {
 		return false, err
 	}
 
-	for _, i := range interfaces.Payload.Results {
-		if i.ID == *objectID {
-			if i.VirtualMachine != nil {
-				vmIDStr := fmt.Sprintf("%d", i.VirtualMachine.ID)
-				paramsVM := virtualization.NewVirtualizationVirtualMachinesListParams().WithID(&vmIDStr)
-				vms, err := client.Virtualization.VirtualizationVirtualMachinesList(
-					paramsVM, nil)
-
-				if err != nil {
-					return false, err
-				}
-
-				if *vms.Payload.Count != 1 {
-					return false, fmt.Errorf("Cannot set an interface as primary when " +
-						"the interface is not attached to a VM.")
-				}
-
-				if ip4 && vms.Payload.Results[0].PrimaryIp4 != nil {
-					return vms.Payload.Results[0].PrimaryIp4.ID == ipID, nil
-				} else if !ip4 && vms.Payload.Results[0].PrimaryIp6 != nil {
-					return vms.Payload.Results[0].PrimaryIp6.ID == ipID, nil
-				} else {
-					return false, nil
-				}
-			} else {
-				return false, fmt.Errorf("Cannot set an interface as primary when the " +
-					"interface is not attached to a VM.")
-			}
-		}
+	if ip4 {
+		return primaryIP4ID == ipID, nil
 	}
 
-	return false, nil
+	return primaryIP6ID == ipID, nil
 }
 
-func updatePrimaryStatus(m interface{}, vmid, ipid int64, primary bool) error {
-	client := m.(*netboxclient.NetBoxAPI)
-
+// updatePrimaryStatus sets (or unsets) the IP address ipID as primary IPv4 or
+// IPv6 address of the device or of the virtual machine parentID depending on
+// objectType.
+func updatePrimaryStatus(client *netboxclient.NetBoxAPI, objectType string,
+	parentID, ipID int64, ip4, primary bool) error {
 	emptyFields := make(map[string]interface{})
+	var primaryIP4, primaryIP6 *int64
+
+	switch {
+	case primary && ip4:
+		primaryIP4 = &ipID
+	case primary:
+		primaryIP6 = &ipID
+	case ip4:
+		emptyFields["primary_ip4"] = nil
+	default:
+		emptyFields["primary_ip6"] = nil
+	}
+
+	if objectType == dcimInterfaceType {
+		dropFields := []string{
+			"created",
+			"last_updated",
+			"device_role",
+			"device_type",
+			"face",
+			"name",
+			"rack",
+			"site",
+			"tags",
+			"tenant",
+			"virtual_chassis",
+		}
+
+		params := &models.WritableDeviceWithConfigContext{
+			PrimaryIp4: primaryIP4,
+			PrimaryIp6: primaryIP6,
+		}
+		device := dcim.NewDcimDevicesPartialUpdateParams().WithData(params)
+		device.SetID(parentID)
+		_, err := client.Dcim.DcimDevicesPartialUpdate(device, nil,
+			requestmodifier.NewRequestModifierOperation(emptyFields, dropFields))
+		return err
+	}
+
 	dropFields := []string{
 		"created",
 		"last_updated",
@@ -136,19 +206,130 @@ func updatePrimaryStatus(m interface{}, vmid, ipid int64, primary bool) error {
 		"tags",
 	}
 
-	params := &models.WritableVirtualMachineWithConfigContext{}
-	if primary {
-		params.PrimaryIp4 = &ipid
-	} else {
-		params.PrimaryIp4 = nil
-		emptyFields["primary_ip4"] = nil
+	params := &models.WritableVirtualMachineWithConfigContext{
+		PrimaryIp4: primaryIP4,
+		PrimaryIp6: primaryIP6,
 	}
 	vm := virtualization.NewVirtualizationVirtualMachinesPartialUpdateParams().WithData(params)
-	vm.SetID(vmid)
+	vm.SetID(parentID)
 	_, err := client.Virtualization.VirtualizationVirtualMachinesPartialUpdate(
 		vm, nil, requestmodifier.NewRequestModifierOperation(emptyFields, dropFields))
+	return err
+}
+
+// setPrimary sets (or unsets) the IP address ipID as primary IP address of
+// its family on the device or the virtual machine of the interface objectID.
+func setPrimary(client *netboxclient.NetBoxAPI, objectType string, objectID,
+	ipID int64, ip4, primary bool) error {
+	if objectID == 0 || !isInterfaceType(objectType) {
+		if !primary {
+			return nil
+		}
+		return fmt.Errorf("Cannot set an IP address as primary when it is not " +
+			"assigned to an interface of a device or a virtual machine.")
+	}
+
+	parentID, err := getInterfaceParentID(client, objectType, objectID)
+	if err != nil {
+		return err
+	}
+
+	return updatePrimaryStatus(client, objectType, parentID, ipID, ip4, primary)
+}
+
+// getInterfaceIDByName returns the ID of the interface name of the device or
+// of the virtual machine parentName depending on objectType.
+func getInterfaceIDByName(client *netboxclient.NetBoxAPI, objectType, parentName,
+	name string) (int64, error) {
+	if objectType == dcimInterfaceType {
+		params := dcim.NewDcimInterfacesListParams().WithDevice(&parentName).WithName(&name)
+		interfaces, err := client.Dcim.DcimInterfacesList(params, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		if *interfaces.Payload.Count != 1 {
+			return 0, fmt.Errorf("interface %s not found on device %s", name, parentName)
+		}
+
+		return interfaces.Payload.Results[0].ID, nil
+	}
+
+	params := virtualization.NewVirtualizationInterfacesListParams().WithVirtualMachine(
+		&parentName).WithName(&name)
+	interfaces, err := client.Virtualization.VirtualizationInterfacesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if *interfaces.Payload.Count != 1 {
+		return 0, fmt.Errorf("interface %s not found on virtual machine %s", name, parentName)
+	}
+
+	return interfaces.Payload.Results[0].ID, nil
+}
+
+// getInterfaceParentID returns the ID of the device or of the virtual machine
+// owning the interface objectID depending on objectType.
+func getInterfaceParentID(client *netboxclient.NetBoxAPI, objectType string,
+	objectID int64) (int64, error) {
+	if objectType == vMInterfaceType {
+		return getVMIDForInterface(client, objectID)
+	}
+	if objectType != dcimInterfaceType {
+		return 0, fmt.Errorf("Object type %s is not an interface.", objectType)
+	}
+
+	params := dcim.NewDcimInterfacesReadParams().WithID(objectID)
+	resource, err := client.Dcim.DcimInterfacesRead(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if resource.Payload.Device == nil {
+		return 0, fmt.Errorf("Interface %d is not attached to a device.", objectID)
+	}
+
+	return resource.Payload.Device.ID, nil
+}
+
+// releasePrimaryIP unsets the IP address ipID as primary IP of the parent of
+// the interface it is assigned to when it moves to an interface of another
+// parent (or to no interface), Netbox refuses to reassign a primary IP.
+// Nothing is released when it was not assigned to an interface.
+func releasePrimaryIP(client *netboxclient.NetBoxAPI, oldType string, oldID int64,
+	newType string, newID int64, ipID int64) error {
+	if !isInterfaceType(oldType) {
+		return nil
+	}
+
+	oldParentID, err := getInterfaceParentID(client, oldType, oldID)
 	if err != nil {
 		return err
 	}
+
+	if newID != 0 && newType == oldType {
+		newParentID, err := getInterfaceParentID(client, newType, newID)
+		if err != nil {
+			return err
+		}
+
+		if newParentID == oldParentID {
+			return nil
+		}
+	}
+
+	primaryIP4ID, primaryIP6ID, err := getPrimaryIPs(client, oldType, oldParentID)
+	if err != nil {
+		return err
+	}
+
+	switch ipID {
+	case primaryIP4ID:
+		return updatePrimaryStatus(client, oldType, oldParentID, ipID, true, false)
+	case primaryIP6ID:
+		return updatePrimaryStatus(client, oldType, oldParentID, ipID, false, false)
+	}
+
 	return nil
 }
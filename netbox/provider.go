@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/go-openapi/runtime"
 	runtimeclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -149,14 +150,23 @@ func Provider() *schema.Provider {
 			"netbox_json_wireless_wireless_lan_groups_list":       json.DataNetboxJSONWirelessWirelessLanGroupsList(),
 			"netbox_json_wireless_wireless_lans_list":             json.DataNetboxJSONWirelessWirelessLansList(),
 			"netbox_json_wireless_wireless_links_list":            json.DataNetboxJSONWirelessWirelessLinksList(),
+			"netbox_dcim_cable_trace":                             dcim.DataNetboxDcimCableTrace(),
+			"netbox_dcim_location":                                dcim.DataNetboxDcimLocation(),
 			"netbox_dcim_platform":                                dcim.DataNetboxDcimPlatform(),
+			"netbox_dcim_rack_elevation":                          dcim.DataNetboxDcimRackElevation(),
+			"netbox_dcim_region":                                  dcim.DataNetboxDcimRegion(),
 			"netbox_dcim_site":                                    dcim.DataNetboxDcimSite(),
+			"netbox_dcim_site_group":                              dcim.DataNetboxDcimSiteGroup(),
 			"netbox_ipam_aggregate":                               ipam.DataNetboxIpamAggregate(),
+			"netbox_ipam_dns_records":                             ipam.DataNetboxIpamDNSRecords(),
 			"netbox_ipam_ip_addresses":                            ipam.DataNetboxIpamIPAddresses(),
+			"netbox_ipam_prefix_utilization":                      ipam.DataNetboxIpamPrefixUtilization(),
 			"netbox_ipam_role":                                    ipam.DataNetboxIpamRole(),
+			"netbox_ipam_route_target":                            ipam.DataNetboxIpamRouteTarget(),
 			"netbox_ipam_service":                                 ipam.DataNetboxIpamService(),
 			"netbox_ipam_vlan":                                    ipam.DataNetboxIpamVlan(),
 			"netbox_ipam_vlan_group":                              ipam.DataNetboxIpamVlanGroup(),
+			"netbox_ipam_vrf":                                     ipam.DataNetboxIpamVrf(),
 			"netbox_tenancy_contact":                              tenancy.DataNetboxTenancyContact(),
 			"netbox_tenancy_contact_group":                        tenancy.DataNetboxTenancyContactGroup(),
 			"netbox_tenancy_contact_role":                         tenancy.DataNetboxTenancyContactRole(),
@@ -165,21 +175,53 @@ func Provider() *schema.Provider {
 			"netbox_virtualization_cluster":                       virtualization.DataNetboxVirtualizationCluster(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
+			"netbox_dcim_location":                dcim.ResourceNetboxDcimLocation(),
 			"netbox_dcim_manufacturer":            dcim.ResourceNetboxDcimManufacturer(),
+			"netbox_dcim_cable":                   dcim.ResourceNetboxDcimCable(),
+			"netbox_dcim_console_port":            dcim.ResourceNetboxDcimConsolePort(),
+			"netbox_dcim_console_server_port":     dcim.ResourceNetboxDcimConsoleServerPort(),
+			"netbox_dcim_device":                  dcim.ResourceNetboxDcimDevice(),
 			"netbox_dcim_device_role":             dcim.ResourceNetboxDcimDeviceRole(),
+			"netbox_dcim_device_primary_ip":       dcim.ResourceNetboxDcimDevicePrimaryIP(),
+			"netbox_dcim_device_type":             dcim.ResourceNetboxDcimDeviceType(),
+			"netbox_dcim_front_port":              dcim.ResourceNetboxDcimFrontPort(),
+			"netbox_dcim_interface":               dcim.ResourceNetboxDcimInterface(),
+			"netbox_dcim_inventory_item":          dcim.ResourceNetboxDcimInventoryItem(),
+			"netbox_dcim_inventory_item_role":     dcim.ResourceNetboxDcimInventoryItemRole(),
+			"netbox_dcim_inventory_item_template": dcim.ResourceNetboxDcimInventoryItemTemplate(),
+			"netbox_dcim_module":                  dcim.ResourceNetboxDcimModule(),
+			"netbox_dcim_module_bay":              dcim.ResourceNetboxDcimModuleBay(),
+			"netbox_dcim_module_type":             dcim.ResourceNetboxDcimModuleType(),
 			"netbox_dcim_platform":                dcim.ResourceNetboxDcimPlatform(),
+			"netbox_dcim_power_outlet":            dcim.ResourceNetboxDcimPowerOutlet(),
+			"netbox_dcim_power_port":              dcim.ResourceNetboxDcimPowerPort(),
+			"netbox_dcim_rack":                    dcim.ResourceNetboxDcimRack(),
+			"netbox_dcim_rack_reservation":        dcim.ResourceNetboxDcimRackReservation(),
+			"netbox_dcim_rack_role":               dcim.ResourceNetboxDcimRackRole(),
+			"netbox_dcim_rear_port":               dcim.ResourceNetboxDcimRearPort(),
+			"netbox_dcim_region":                  dcim.ResourceNetboxDcimRegion(),
 			"netbox_dcim_site":                    dcim.ResourceNetboxDcimSite(),
+			"netbox_dcim_site_group":              dcim.ResourceNetboxDcimSiteGroup(),
 			"netbox_extras_custom_field":          extras.ResourceNetboxExtrasCustomField(),
 			"netbox_extras_tag":                   extras.ResourceNetboxExtrasTag(),
 			"netbox_ipam_aggregate":               ipam.ResourceNetboxIpamAggregate(),
 			"netbox_ipam_asn":                     ipam.ResourceNetboxIpamASN(),
+			"netbox_ipam_fhrp_group":              ipam.ResourceNetboxIpamFhrpGroup(),
+			"netbox_ipam_fhrp_group_assignment":   ipam.ResourceNetboxIpamFhrpGroupAssignment(),
 			"netbox_ipam_ip_addresses":            ipam.ResourceNetboxIpamIPAddresses(),
 			"netbox_ipam_ip_range":                ipam.ResourceNetboxIpamIPRange(),
+			"netbox_ipam_l2vpn":                   ipam.ResourceNetboxIpamL2vpn(),
+			"netbox_ipam_l2vpn_termination":       ipam.ResourceNetboxIpamL2vpnTermination(),
+			"netbox_ipam_nat_mapping":             ipam.ResourceNetboxIpamNatMapping(),
 			"netbox_ipam_prefix":                  ipam.ResourceNetboxIpamPrefix(),
 			"netbox_ipam_rir":                     ipam.ResourceNetboxIpamRIR(),
+			"netbox_ipam_role":                    ipam.ResourceNetboxIpamRole(),
+			"netbox_ipam_route_target":            ipam.ResourceNetboxIpamRouteTarget(),
 			"netbox_ipam_service":                 ipam.ResourceNetboxIpamService(),
+			"netbox_ipam_service_template":        ipam.ResourceNetboxIpamServiceTemplate(),
 			"netbox_ipam_vlan":                    ipam.ResourceNetboxIpamVlan(),
 			"netbox_ipam_vlan_group":              ipam.ResourceNetboxIpamVlanGroup(),
+			"netbox_ipam_vrf":                     ipam.ResourceNetboxIpamVrf(),
 			"netbox_tenancy_contact":              tenancy.ResourceNetboxTenancyContact(),
 			"netbox_tenancy_contact_assignment":   tenancy.ResourceNetboxTenancyContactAssignment(),
 			"netbox_tenancy_contact_group":        tenancy.ResourceNetboxTenancyContactGroup(),
@@ -225,6 +267,8 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 	t := runtimeclient.NewWithClient(url, basepath, defaultScheme, cli)
 	t.DefaultAuthentication = runtimeclient.APIKeyAuth(authHeaderName, "header",
 		fmt.Sprintf(authHeaderFormat, token))
+	// The elevation of the racks can be rendered as SVG
+	t.Consumers["image/svg+xml"] = runtime.TextConsumer()
 
 	return client.New(t, strfmt.Default), nil
 }